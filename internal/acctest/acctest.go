@@ -110,6 +110,8 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
+	configureEmulator(Provider)
 }
 
 func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string) map[string]func() (tfprotov5.ProviderServer, error) {
@@ -117,12 +119,14 @@ func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 
 	for _, name := range providerNames {
 		factories[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+			providerServerFactory, p, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 			if err != nil {
 				return nil, err
 			}
 
+			configureEmulator(p)
+
 			return providerServerFactory(), nil
 		}
 	}
@@ -140,6 +144,8 @@ func protoV5ProviderFactoriesNamedInit(ctx context.Context, t *testing.T, provid
 			t.Fatal(err)
 		}
 
+		configureEmulator(p)
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -160,6 +166,8 @@ func protoV5ProviderFactoriesPlusProvidersInit(ctx context.Context, t *testing.T
 			t.Fatal(err)
 		}
 
+		configureEmulator(p)
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -233,10 +241,15 @@ func ProtoV5FactoriesMultipleRegions(ctx context.Context, t *testing.T, n int) m
 // These verifications and configuration are preferred at this level to prevent
 // provider developers from experiencing less clear errors for every test.
 func PreCheck(ctx context.Context, t *testing.T) {
+	preCheckEmulator(t)
+
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
-		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		// A local emulator accepts any credentials.
+		if !isEmulatorEnabled() {
+			envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		}
 
 		if os.Getenv(envvar.AccessKeyId) != "" {
 			envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
@@ -252,6 +265,11 @@ func PreCheck(ctx context.Context, t *testing.T) {
 		region := Region()
 		os.Setenv(envvar.DefaultRegion, region)
 
+		// Count AWS API calls made by CheckDestroy and CheckExists functions.
+		if isReportEnabled() {
+			configureReportHTTPClient(Provider, "")
+		}
+
 		diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(nil))
 		if err := sdkdiag.DiagnosticsError(diags); err != nil {
 			t.Fatalf("configuring provider: %s", err)
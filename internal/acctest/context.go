@@ -32,9 +32,21 @@ func logger(ctx context.Context, t *testing.T, name string) context.Context {
 	return ctx
 }
 
-// testNameContext adds the current test name to loggers.
+type testNameContextKeyType int
+
+var testNameContextKey testNameContextKeyType
+
+// testNameContext adds the current test name to loggers and to Context.
 func testNameContext(ctx context.Context, testName string) context.Context {
 	ctx = tflog.SetField(ctx, "test_name", testName)
+	ctx = context.WithValue(ctx, testNameContextKey, testName)
 
 	return ctx
 }
+
+// testNameFromContext returns the test name in Context, or "" if there is none.
+func testNameFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(testNameContextKey).(string)
+
+	return v
+}
@@ -0,0 +1,99 @@
+package acctest
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	envVarEmulatorEndpoint = "TF_ACC_EMULATOR_ENDPOINT"
+)
+
+const (
+	// Static credentials used when none are configured. Emulators accept any credentials.
+	emulatorAccessKey = "test"
+	emulatorSecretKey = "test"
+)
+
+// emulatorCompatibleTests is the set of names of tests declared compatible with a local emulator.
+var emulatorCompatibleTests sync.Map
+
+func isEmulatorEnabled() bool {
+	return os.Getenv(envVarEmulatorEndpoint) != ""
+}
+
+// PreCheckEmulatorCompatible replaces PreCheck for tests that can run against a local AWS emulator.
+// When TF_ACC_EMULATOR_ENDPOINT is set, tests that don't use this PreCheck are skipped.
+func PreCheckEmulatorCompatible(ctx context.Context, t *testing.T) {
+	emulatorCompatibleTests.Store(t.Name(), struct{}{})
+
+	PreCheck(ctx, t)
+}
+
+// preCheckEmulator skips the test if it's running against a local emulator and hasn't been declared compatible.
+func preCheckEmulator(t *testing.T) {
+	if !isEmulatorEnabled() {
+		return
+	}
+
+	if _, ok := emulatorCompatibleTests.Load(t.Name()); !ok {
+		t.Skipf("skipping test; %s set and test not declared emulator compatible", envVarEmulatorEndpoint)
+	}
+}
+
+// emulatorProviderConfig returns provider configuration routing all service endpoints to the local emulator
+// and skipping validations that require a real AWS account.
+func emulatorProviderConfig() map[string]any {
+	endpoint := os.Getenv(envVarEmulatorEndpoint)
+	endpoints := make(map[string]any)
+
+	for _, alias := range names.Aliases() {
+		endpoints[alias] = endpoint
+	}
+
+	config := map[string]any{
+		"endpoints":                   []any{endpoints},
+		"s3_use_path_style":           true,
+		"skip_credentials_validation": true,
+		"skip_metadata_api_check":     "true",
+		"skip_region_validation":      true,
+		"skip_requesting_account_id":  true,
+	}
+
+	if os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" {
+		config["access_key"] = emulatorAccessKey
+		config["secret_key"] = emulatorSecretKey
+	}
+
+	return config
+}
+
+// configureEmulator routes the provider's API requests to the local emulator, if enabled.
+func configureEmulator(provider *schema.Provider) {
+	if !isEmulatorEnabled() {
+		return
+	}
+
+	provider.ConfigureContextFunc = emulatorProviderConfigureContextFunc(provider.ConfigureContextFunc)
+}
+
+// emulatorProviderConfigureContextFunc returns a provider configuration function that overrides the configured
+// endpoints and validations with the local emulator configuration.
+func emulatorProviderConfigureContextFunc(configureContextFunc schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		for k, v := range emulatorProviderConfig() {
+			if err := d.Set(k, v); err != nil {
+				return nil, diag.Errorf("setting %s: %s", k, err)
+			}
+		}
+
+		return configureContextFunc(ctx, d)
+	}
+}
@@ -0,0 +1,55 @@
+package acctest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+func TestConfigureEmulator(t *testing.T) { //nolint:paralleltest
+	const endpoint = "http://localhost:4566"
+
+	ctx := context.Background()
+
+	t.Setenv("TF_ACC_EMULATOR_ENDPOINT", endpoint)
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_DEFAULT_REGION", "us-west-2")
+	t.Setenv("AWS_PROFILE", "")
+
+	p, err := provider.New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	acctest.ConfigureEmulator(p)
+
+	if err := sdkdiag.DiagnosticsError(p.Configure(ctx, terraform.NewResourceConfigRaw(nil))); err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+
+	meta := p.Meta().(*conns.AWSClient)
+
+	if got := meta.EC2Conn(ctx).Endpoint; got != endpoint {
+		t.Errorf("EC2 endpoint: got %s, want %s", got, endpoint)
+	}
+
+	if got := meta.AccountID; got != "" {
+		t.Errorf("account ID: got %s, want none", got)
+	}
+}
+
+func TestPreCheckEmulator(t *testing.T) { //nolint:paralleltest
+	t.Setenv("TF_ACC_EMULATOR_ENDPOINT", "http://localhost:4566")
+
+	t.Run("not compatible", func(t *testing.T) {
+		acctest.PreCheckEmulator(t)
+
+		t.Error("test not skipped")
+	})
+}
@@ -2,5 +2,13 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder          = closeVCRRecorder
+	ConfigureEmulator         = configureEmulator
+	ConfigureReportHTTPClient = configureReportHTTPClient
+	CurrentTestStep           = currentTestStep
+	NewStepRecorder           = newStepRecorder
+	PreCheckEmulator          = preCheckEmulator
+	ReportTestCase            = reportTestCase
+	VCRTestSteps              = vcrTestSteps
+	WriteReport               = writeReport
 )
@@ -0,0 +1,300 @@
+package acctest
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/report"
+	"github.com/hashicorp/terraform-provider-aws/internal/audit"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+const (
+	envVarReportFile = "TF_ACC_REPORT_FILE"
+)
+
+type reportAuditLogMap map[string]string
+
+func (m reportAuditLogMap) Lock() {
+	reportAuditLogsLock.Lock()
+}
+
+func (m reportAuditLogMap) Unlock() {
+	reportAuditLogsLock.Unlock()
+}
+
+var (
+	reportAuditLogsLock sync.Mutex
+	// Per-test audit log files, keyed by test name.
+	reportAuditLogs = make(reportAuditLogMap)
+	// Per-test audit loggers opened by the test's providers, keyed by test name.
+	reportAuditLoggers = make(map[string][]*audit.Logger)
+	// Per-test AWS API call counts, keyed by test name and then by AWS service signing name.
+	reportAPICalls = make(map[string]map[string]int)
+)
+
+func isReportEnabled() bool {
+	return os.Getenv(envVarReportFile) != ""
+}
+
+// reportTestCase records the test's wall time, AWS API calls and the resource types created.
+// The test's providers write an audit log from which the resource types created are found when the test completes.
+// AWS API calls made by the test's providers, and by the shared acceptance test provider with the test's Context
+// (e.g. in CheckDestroy and CheckExists functions), are counted by the providers' HTTP transport.
+func reportTestCase(t *testing.T, c resource.TestCase) resource.TestCase {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	reportAuditLogs.Lock()
+	reportAuditLogs[t.Name()] = path
+	reportAPICalls[t.Name()] = make(map[string]int)
+	reportAuditLogs.Unlock()
+
+	// Parallel tests are paused before PreCheck is called.
+	var start time.Time
+	preCheck := c.PreCheck
+	c.PreCheck = func() {
+		start = time.Now()
+
+		if preCheck != nil {
+			preCheck()
+		}
+	}
+
+	if !isVCREnabled() {
+		c.ProtoV5ProviderFactories = reportEnabledProtoV5ProviderFactories(t, c.ProtoV5ProviderFactories)
+	}
+
+	// Registered after t.TempDir so that it's called before the audit log is removed.
+	t.Cleanup(func() {
+		reportAuditLogs.Lock()
+		delete(reportAuditLogs, t.Name())
+		loggers := reportAuditLoggers[t.Name()]
+		delete(reportAuditLoggers, t.Name())
+		apiCalls := reportAPICalls[t.Name()]
+		delete(reportAPICalls, t.Name())
+		reportAuditLogs.Unlock()
+
+		for _, logger := range loggers {
+			if err := logger.Close(); err != nil {
+				t.Errorf("closing audit log: %s", err)
+			}
+		}
+
+		if start.IsZero() {
+			start = time.Now()
+		}
+
+		if err := writeReport(t, start, path, apiCalls); err != nil {
+			t.Errorf("writing acceptance test report: %s", err)
+		}
+	})
+
+	return c
+}
+
+// reportEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories whose providers write the test's audit log.
+func reportEnabledProtoV5ProviderFactories(t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
+	output := make(map[string]func() (tfprotov5.ProviderServer, error), len(input))
+
+	for name := range input {
+		output[name] = func() (tfprotov5.ProviderServer, error) {
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(context.Background())
+
+			if err != nil {
+				return nil, err
+			}
+
+			configureEmulator(primary)
+			configureReport(primary, t.Name())
+
+			return providerServerFactory(), nil
+		}
+	}
+
+	return output
+}
+
+// configureReport has the provider write the test's audit log, if enabled.
+func configureReport(provider *schema.Provider, testName string) {
+	if !isReportEnabled() {
+		return
+	}
+
+	reportAuditLogs.Lock()
+	path, ok := reportAuditLogs[testName]
+	reportAuditLogs.Unlock()
+
+	if !ok {
+		return
+	}
+
+	provider.ConfigureContextFunc = reportProviderConfigureContextFunc(provider, provider.ConfigureContextFunc, testName, path)
+}
+
+// reportProviderConfigureContextFunc returns a provider configuration function that sets the audit log file
+// unless one is configured and that counts the provider's AWS API calls.
+func reportProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName, path string) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		if d.Get("audit_log_file").(string) == "" {
+			if err := d.Set("audit_log_file", path); err != nil {
+				return nil, diag.Errorf("setting audit_log_file: %s", err)
+			}
+		}
+
+		configureReportHTTPClient(provider, testName)
+
+		meta, diags := configureContextFunc(ctx, d)
+
+		if v, ok := meta.(*conns.AWSClient); ok && v.AuditLogger != nil {
+			reportAuditLogs.Lock()
+			reportAuditLoggers[testName] = append(reportAuditLoggers[testName], v.AuditLogger)
+			reportAuditLogs.Unlock()
+		}
+
+		return meta, diags
+	}
+}
+
+// configureReportHTTPClient has the provider count its AWS API calls for the specified test.
+// If no test name is specified, calls are counted for the test whose name is in the request's Context.
+// It must be called before the provider is configured.
+func configureReportHTTPClient(provider *schema.Provider, testName string) {
+	var meta *conns.AWSClient
+	if v, ok := provider.Meta().(*conns.AWSClient); ok {
+		meta = v
+	} else {
+		meta = new(conns.AWSClient)
+		provider.SetMeta(meta)
+	}
+
+	if meta.Session != nil {
+		return
+	}
+
+	// Any existing HTTP client, e.g. a VCR recorder, is used for the calls.
+	httpClient := meta.HTTPClient()
+	if httpClient == nil {
+		httpClient = vcr.NewHTTPClient()
+	}
+
+	if _, ok := httpClient.Transport.(*reportTransport); ok {
+		return
+	}
+
+	meta.SetHTTPClient(&http.Client{
+		CheckRedirect: httpClient.CheckRedirect,
+		Jar:           httpClient.Jar,
+		Timeout:       httpClient.Timeout,
+		Transport: &reportTransport{
+			next:     httpClient.Transport,
+			testName: testName,
+		},
+	})
+}
+
+// reportTransport is an http.RoundTripper that counts AWS API calls, including retries, per service.
+type reportTransport struct {
+	next     http.RoundTripper
+	testName string // If empty, the test name is taken from the request's Context.
+}
+
+func (t *reportTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	testName := t.testName
+	if testName == "" {
+		testName = testNameFromContext(request.Context())
+	}
+
+	reportAuditLogs.Lock()
+	if apiCalls, ok := reportAPICalls[testName]; ok {
+		apiCalls[vcr.ServiceName(request)]++
+	}
+	reportAuditLogs.Unlock()
+
+	return t.next.RoundTrip(request)
+}
+
+// writeReport appends the test's report built from the specified audit log and AWS API call counts to the report file.
+func writeReport(t *testing.T, start time.Time, auditLogPath string, apiCalls map[string]int) error {
+	test := report.Test{
+		Name:           t.Name(),
+		Start:          start,
+		DurationMillis: time.Since(start).Milliseconds(),
+		Failed:         t.Failed(),
+		Skipped:        t.Skipped(),
+		APICalls:       apiCalls,
+	}
+
+	// Tests are run in the service package's directory.
+	if wd, err := os.Getwd(); err == nil {
+		test.Package = filepath.Base(wd)
+	}
+
+	records, err := readAuditLog(auditLogPath)
+
+	if err != nil {
+		return err
+	}
+
+	resourceTypes := make(map[string]struct{})
+	for _, record := range records {
+		if record.Operation == audit.OperationCreate && record.Error == "" {
+			resourceTypes[record.ResourceType] = struct{}{}
+		}
+	}
+
+	for k := range resourceTypes {
+		test.ResourceTypes = append(test.ResourceTypes, k)
+	}
+	sort.Strings(test.ResourceTypes)
+
+	return report.Append(os.Getenv(envVarReportFile), test)
+}
+
+// readAuditLog returns the records in the specified audit log.
+// No records are returned if the audit log doesn't exist, e.g. the test was skipped.
+func readAuditLog(path string) ([]audit.Record, error) {
+	file, err := os.Open(path)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	var records []audit.Record
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	for scanner.Scan() {
+		var record audit.Record
+
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("reading audit log (%s): %w", path, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, scanner.Err()
+}
@@ -0,0 +1,304 @@
+// Package report implements acceptance test cost and duration reports.
+// Each acceptance test is recorded as a single JSON object on its own line (JSON Lines)
+// together with the number of AWS API calls made per service and the resource types created.
+package report
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Test represents a single acceptance test run.
+type Test struct {
+	Name           string         `json:"name"`
+	Package        string         `json:"package"`
+	Start          time.Time      `json:"start"`
+	DurationMillis int64          `json:"duration_ms"`
+	Failed         bool           `json:"failed,omitempty"`
+	Skipped        bool           `json:"skipped,omitempty"`
+	APICalls       map[string]int `json:"api_calls"`      // Keyed by AWS service signing name.
+	ResourceTypes  []string       `json:"resource_types"` // Resource types created.
+}
+
+// TotalAPICalls returns the number of AWS API calls made by the test.
+func (t Test) TotalAPICalls() int {
+	var n int
+
+	for _, v := range t.APICalls {
+		n += v
+	}
+
+	return n
+}
+
+// Service represents the aggregated acceptance test runs for a service package.
+type Service struct {
+	Package        string   `json:"package"`
+	Tests          int      `json:"tests"`
+	Failed         int      `json:"failed"`
+	Skipped        int      `json:"skipped"`
+	DurationMillis int64    `json:"duration_ms"`
+	APICalls       int      `json:"api_calls"`
+	ResourceTypes  []string `json:"resource_types"`
+}
+
+// Summary represents an aggregated acceptance test report.
+// Services and tests are ordered by decreasing duration.
+type Summary struct {
+	Services []Service `json:"services"`
+	Tests    []Test    `json:"tests"`
+}
+
+var (
+	appendLock sync.Mutex
+)
+
+// Append appends the specified test to the report file, creating it if necessary.
+// Each test is written with a single write so that test binaries for multiple packages can share a report file.
+func Append(path string, test Test) error {
+	if test.APICalls == nil {
+		test.APICalls = map[string]int{}
+	}
+	if test.ResourceTypes == nil {
+		test.ResourceTypes = []string{}
+	}
+
+	b, err := json.Marshal(test)
+
+	if err != nil {
+		return err
+	}
+
+	appendLock.Lock()
+	defer appendLock.Unlock()
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return fmt.Errorf("opening acceptance test report (%s): %w", path, err)
+	}
+
+	_, err = file.Write(append(b, '\n'))
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// Read returns the tests in the specified report file.
+func Read(path string) ([]Test, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening acceptance test report (%s): %w", path, err)
+	}
+
+	defer file.Close()
+
+	var tests []Test
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var test Test
+
+		if err := json.Unmarshal(scanner.Bytes(), &test); err != nil {
+			return nil, fmt.Errorf("reading acceptance test report (%s): %w", path, err)
+		}
+
+		tests = append(tests, test)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading acceptance test report (%s): %w", path, err)
+	}
+
+	return tests, nil
+}
+
+// Summarize aggregates the specified tests by service package.
+func Summarize(tests []Test) Summary {
+	services := make(map[string]*Service)
+	resourceTypes := make(map[string]map[string]struct{})
+
+	for _, test := range tests {
+		service, ok := services[test.Package]
+		if !ok {
+			service = &Service{Package: test.Package}
+			services[test.Package] = service
+			resourceTypes[test.Package] = make(map[string]struct{})
+		}
+
+		service.Tests++
+		if test.Failed {
+			service.Failed++
+		}
+		if test.Skipped {
+			service.Skipped++
+		}
+		service.DurationMillis += test.DurationMillis
+		service.APICalls += test.TotalAPICalls()
+
+		for _, v := range test.ResourceTypes {
+			resourceTypes[test.Package][v] = struct{}{}
+		}
+	}
+
+	summary := Summary{
+		Services: make([]Service, 0, len(services)),
+		Tests:    append([]Test{}, tests...),
+	}
+
+	for k, service := range services {
+		service.ResourceTypes = make([]string, 0, len(resourceTypes[k]))
+		for v := range resourceTypes[k] {
+			service.ResourceTypes = append(service.ResourceTypes, v)
+		}
+		sort.Strings(service.ResourceTypes)
+
+		summary.Services = append(summary.Services, *service)
+	}
+
+	sort.SliceStable(summary.Services, func(i, j int) bool {
+		if a, b := summary.Services[i].DurationMillis, summary.Services[j].DurationMillis; a != b {
+			return a > b
+		}
+		if a, b := summary.Services[i].APICalls, summary.Services[j].APICalls; a != b {
+			return a > b
+		}
+		return summary.Services[i].Package < summary.Services[j].Package
+	})
+
+	sort.SliceStable(summary.Tests, func(i, j int) bool {
+		if a, b := summary.Tests[i].DurationMillis, summary.Tests[j].DurationMillis; a != b {
+			return a > b
+		}
+		return summary.Tests[i].Name < summary.Tests[j].Name
+	})
+
+	return summary
+}
+
+// WriteJSON writes the summary as indented JSON.
+func (s Summary) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(s)
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Failure    *junitMessage   `xml:"failure,omitempty"`
+	Skipped    *junitMessage   `xml:"skipped,omitempty"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteJUnit writes the summary in JUnit XML format with a test suite per service package.
+// API calls per service and the resource types created are written as test case properties.
+func (s Summary) WriteJUnit(w io.Writer) error {
+	var suites junitTestSuites
+
+	for _, service := range s.Services {
+		suite := junitTestSuite{
+			Name:     service.Package,
+			Tests:    service.Tests,
+			Failures: service.Failed,
+			Skipped:  service.Skipped,
+			Time:     seconds(service.DurationMillis),
+		}
+
+		for _, test := range s.Tests {
+			if test.Package != service.Package {
+				continue
+			}
+
+			testCase := junitTestCase{
+				Name:      test.Name,
+				ClassName: test.Package,
+				Time:      seconds(test.DurationMillis),
+			}
+
+			services := make([]string, 0, len(test.APICalls))
+			for k := range test.APICalls {
+				services = append(services, k)
+			}
+			sort.Strings(services)
+
+			for _, k := range services {
+				testCase.Properties = append(testCase.Properties, junitProperty{Name: "api_calls." + k, Value: fmt.Sprint(test.APICalls[k])})
+			}
+			for _, v := range test.ResourceTypes {
+				testCase.Properties = append(testCase.Properties, junitProperty{Name: "resource_type", Value: v})
+			}
+
+			if test.Failed {
+				testCase.Failure = &junitMessage{Message: "Failed"}
+			}
+			if test.Skipped {
+				testCase.Skipped = &junitMessage{Message: "Skipped"}
+			}
+
+			suite.TestCases = append(suite.TestCases, testCase)
+		}
+
+		suites.TestSuites = append(suites.TestSuites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+func seconds(millis int64) string {
+	return fmt.Sprintf("%.3f", float64(millis)/1000)
+}
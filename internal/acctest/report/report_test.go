@@ -0,0 +1,109 @@
+package report_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/report"
+)
+
+func TestAppendRead(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "report.jsonl")
+	tests := []report.Test{
+		{
+			Name:           "TestAccVPCVPC_basic",
+			Package:        "ec2",
+			Start:          time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+			DurationMillis: 30000,
+			APICalls:       map[string]int{"EC2": 12},
+			ResourceTypes:  []string{"aws_vpc"},
+		},
+		{
+			Name:           "TestAccVPCVPC_disappears",
+			Package:        "ec2",
+			Start:          time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+			DurationMillis: 10,
+			Skipped:        true,
+			APICalls:       map[string]int{},
+			ResourceTypes:  []string{},
+		},
+	}
+
+	for _, test := range tests {
+		if err := report.Append(path, test); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	got, err := report.Read(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, tests); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	tests := []report.Test{
+		{Name: "TestAccS3Bucket_basic", Package: "s3", DurationMillis: 1000, APICalls: map[string]int{"S3": 20}, ResourceTypes: []string{"aws_s3_bucket"}},
+		{Name: "TestAccVPCVPC_basic", Package: "ec2", DurationMillis: 3000, APICalls: map[string]int{"EC2": 12}, ResourceTypes: []string{"aws_vpc"}},
+		{Name: "TestAccVPCSubnet_basic", Package: "ec2", DurationMillis: 2000, APICalls: map[string]int{"EC2": 10}, ResourceTypes: []string{"aws_subnet", "aws_vpc"}, Failed: true},
+	}
+
+	got := report.Summarize(tests)
+
+	wantServices := []report.Service{
+		{Package: "ec2", Tests: 2, Failed: 1, DurationMillis: 5000, APICalls: 22, ResourceTypes: []string{"aws_subnet", "aws_vpc"}},
+		{Package: "s3", Tests: 1, DurationMillis: 1000, APICalls: 20, ResourceTypes: []string{"aws_s3_bucket"}},
+	}
+
+	if diff := cmp.Diff(got.Services, wantServices); diff != "" {
+		t.Errorf("unexpected services diff (+wanted, -got): %s", diff)
+	}
+
+	var gotTests []string
+	for _, test := range got.Tests {
+		gotTests = append(gotTests, test.Name)
+	}
+
+	if diff := cmp.Diff(gotTests, []string{"TestAccVPCVPC_basic", "TestAccVPCSubnet_basic", "TestAccS3Bucket_basic"}); diff != "" {
+		t.Errorf("unexpected tests diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	t.Parallel()
+
+	summary := report.Summarize([]report.Test{
+		{Name: "TestAccVPCVPC_basic", Package: "ec2", DurationMillis: 1500, APICalls: map[string]int{"EC2": 12}, ResourceTypes: []string{"aws_vpc"}, Failed: true},
+	})
+
+	var b bytes.Buffer
+
+	if err := summary.WriteJUnit(&b); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, want := range []string{
+		`<testsuite name="ec2" tests="1" failures="1" skipped="0" time="1.500">`,
+		`<testcase name="TestAccVPCVPC_basic" classname="ec2" time="1.500">`,
+		`<property name="api_calls.EC2" value="12"></property>`,
+		`<property name="resource_type" value="aws_vpc"></property>`,
+		`<failure message="Failed"></failure>`,
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("JUnit report does not contain %q:\n%s", want, b.String())
+		}
+	}
+}
@@ -3,25 +3,19 @@ package acctest
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
-	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
-	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -29,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
@@ -57,6 +52,20 @@ func (m metaMap) key() string {
 	return "vcr-metas"
 }
 
+type stepMap map[string]int
+
+func (m stepMap) Lock() {
+	conns.GlobalMutexKV.Lock(m.key())
+}
+
+func (m stepMap) Unlock() {
+	conns.GlobalMutexKV.Unlock(m.key())
+}
+
+func (m stepMap) key() string {
+	return "vcr-steps"
+}
+
 type randomnessSourceMap map[string]*randomnessSource
 
 func (m randomnessSourceMap) Lock() {
@@ -74,6 +83,7 @@ func (m randomnessSourceMap) key() string {
 var (
 	providerMetas     = metaMap(make(map[string]*conns.AWSClient, 0))
 	randomnessSources = randomnessSourceMap(make(map[string]*randomnessSource, 0))
+	testSteps         = stepMap(make(map[string]int, 0)) // Current test step number, keyed by test name.
 )
 
 // ProviderMeta returns the current provider's state (AKA "meta" or "conns.AWSClient").
@@ -116,6 +126,8 @@ func vcrEnabledProtoV5ProviderFactories(t *testing.T, input map[string]func() (t
 				return nil, err
 			}
 
+			configureEmulator(primary)
+			configureReport(primary, t.Name())
 			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
 
 			return providerServerFactory(), nil
@@ -144,109 +156,10 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil, diag.FromErr(err)
 		}
 
-		// Cribbed from aws-sdk-go-base.
-		httpClient := cleanhttp.DefaultPooledClient()
-		transport := httpClient.Transport.(*http.Transport)
-		transport.MaxIdleConnsPerHost = 10
-		tlsConfig := transport.TLSClientConfig
-		if tlsConfig == nil {
-			tlsConfig = &tls.Config{}
-			transport.TLSClientConfig = tlsConfig
-		}
-		tlsConfig.MinVersion = tls.VersionTLS12
-
-		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
-
-		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
-
-		if err != nil {
-			return nil, diag.FromErr(err)
-		}
-
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-
-			return nil
-		}, recorder.AfterCaptureHook)
-
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
+		httpClient := vcr.NewHTTPClient()
 
-			return false
-		})
+		// Create a VCR recorder per test step around a default HTTP client.
+		r := newStepRecorder(ctx, vcrMode, filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName)), httpClient.Transport, testName)
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
@@ -283,6 +196,141 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
+// stepRecorder is an http.RoundTripper that records AWS API interactions to, or replays them from, a cassette per test step.
+// Per-step cassettes allow steps to be re-recorded independently and make replaying a step independent of the API calls made in other steps.
+// The cassette for step N is `<path>/step_N.yaml`.
+type stepRecorder struct {
+	ctx           context.Context
+	legacy        bool // Replay a single cassette, `<path>.yaml`, recorded before per-step cassettes for all steps?
+	mode          recorder.Mode
+	path          string // Cassette directory.
+	realTransport http.RoundTripper
+	testName      string
+
+	lock     sync.Mutex
+	recorder *recorder.Recorder
+	step     int
+}
+
+func newStepRecorder(ctx context.Context, mode recorder.Mode, path string, realTransport http.RoundTripper, testName string) *stepRecorder {
+	return &stepRecorder{
+		ctx:           ctx,
+		legacy:        mode == recorder.ModeReplayOnly && isLegacyVCRCassette(path),
+		mode:          mode,
+		path:          path,
+		realTransport: realTransport,
+		testName:      testName,
+	}
+}
+
+// isLegacyVCRCassette returns whether the test's cassettes were recorded as a single cassette, `<path>.yaml`, rather than per step.
+func isLegacyVCRCassette(path string) bool {
+	if _, err := os.Stat(path); err == nil {
+		return false
+	}
+
+	_, err := os.Stat(path + ".yaml")
+
+	return err == nil
+}
+
+func (r *stepRecorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	v, err := r.stepRecorder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return v.RoundTrip(request)
+}
+
+// stepRecorder returns the recorder for the current test step, saving the previous step's cassette.
+func (r *stepRecorder) stepRecorder() (*recorder.Recorder, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	step, cassetteName := currentTestStep(r.testName), ""
+	if r.legacy {
+		step, cassetteName = 0, r.path
+	} else {
+		cassetteName = vcrStepCassetteName(r.path, step)
+	}
+
+	if r.recorder != nil && r.step == step {
+		return r.recorder, nil
+	}
+
+	if r.recorder != nil {
+		if err := r.recorder.Stop(); err != nil {
+			return nil, err
+		}
+	}
+
+	v, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:  cassetteName,
+		Mode:          r.mode,
+		RealTransport: r.realTransport,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove sensitive data.
+	v.AddHook(vcr.RedactSensitiveData, recorder.AfterCaptureHook)
+
+	// Defines how VCR will match requests to responses.
+	v.SetMatcher(vcr.Matcher(r.ctx))
+
+	r.recorder = v
+	r.step = step
+
+	return v, nil
+}
+
+// Stop saves the current test step's cassette.
+func (r *stepRecorder) Stop() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.recorder == nil {
+		return nil
+	}
+
+	return r.recorder.Stop()
+}
+
+func currentTestStep(testName string) int {
+	testSteps.Lock()
+	defer testSteps.Unlock()
+
+	return testSteps[testName]
+}
+
+// vcrTestSteps returns the specified test steps with each step setting the current test step number before any PreConfig is run.
+func vcrTestSteps(t *testing.T, steps []resource.TestStep) []resource.TestStep {
+	testName := t.Name()
+	output := make([]resource.TestStep, len(steps))
+
+	for i, step := range steps {
+		number, preConfig := i+1, step.PreConfig
+
+		step.PreConfig = func() {
+			testSteps.Lock()
+			testSteps[testName] = number
+			testSteps.Unlock()
+
+			if preConfig != nil {
+				preConfig()
+			}
+		}
+
+		output[i] = step
+	}
+
+	return output
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
@@ -334,6 +382,10 @@ func vcrFileName(name string) string {
 	return strings.ReplaceAll(name, "/", "_")
 }
 
+func vcrStepCassetteName(path string, step int) string {
+	return filepath.Join(path, fmt.Sprintf("step_%d", step))
+}
+
 func vcrSeedFile(path, name string) string {
 	return filepath.Join(path, fmt.Sprintf("%s.seed", vcrFileName(name)))
 }
@@ -387,7 +439,11 @@ func closeVCRRecorder(t *testing.T) {
 
 	if ok {
 		if !t.Failed() {
-			if v, ok := meta.HTTPClient().Transport.(*recorder.Recorder); ok {
+			transport := meta.HTTPClient().Transport
+			if v, ok := transport.(*reportTransport); ok {
+				transport = v.next
+			}
+			if v, ok := transport.(*stepRecorder); ok {
 				t.Log("stopping VCR recorder")
 				if err := v.Stop(); err != nil {
 					t.Error(err)
@@ -398,6 +454,10 @@ func closeVCRRecorder(t *testing.T) {
 		delete(providerMetas, testName)
 	}
 
+	testSteps.Lock()
+	delete(testSteps, testName)
+	testSteps.Unlock()
+
 	// Save the randomness seed.
 	randomnessSources.Lock()
 	s, ok := randomnessSources[testName]
@@ -415,20 +475,30 @@ func closeVCRRecorder(t *testing.T) {
 	}
 }
 
-// ParallelTest wraps resource.ParallelTest, initializing VCR if enabled.
+// ParallelTest wraps resource.ParallelTest, initializing VCR and acceptance test reporting if enabled.
 func ParallelTest(t *testing.T, c resource.TestCase) {
+	if isReportEnabled() {
+		c = reportTestCase(t, c)
+	}
+
 	if isVCREnabled() {
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(t, c.ProtoV5ProviderFactories)
+		c.Steps = vcrTestSteps(t, c.Steps)
 		defer closeVCRRecorder(t)
 	}
 
 	resource.ParallelTest(t, c)
 }
 
-// Test wraps resource.Test, initializing VCR if enabled.
+// Test wraps resource.Test, initializing VCR and acceptance test reporting if enabled.
 func Test(t *testing.T, c resource.TestCase) {
+	if isReportEnabled() {
+		c = reportTestCase(t, c)
+	}
+
 	if isVCREnabled() {
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(t, c.ProtoV5ProviderFactories)
+		c.Steps = vcrTestSteps(t, c.Steps)
 		defer closeVCRRecorder(t)
 	}
 
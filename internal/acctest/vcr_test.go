@@ -1,9 +1,18 @@
 package acctest_test
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
 
 func TestRandInt(t *testing.T) { //nolint:paralleltest
@@ -45,3 +54,149 @@ func TestRandomWithPrefix(t *testing.T) { //nolint:paralleltest
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestVCRTestSteps(t *testing.T) { //nolint:paralleltest
+	t.Setenv("VCR_PATH", t.TempDir())
+	t.Setenv("VCR_MODE", "RECORDING")
+
+	var preConfigs []int
+	steps := acctest.VCRTestSteps(t, []resource.TestStep{
+		{},
+		{
+			PreConfig: func() {
+				preConfigs = append(preConfigs, acctest.CurrentTestStep(t.Name()))
+			},
+		},
+	})
+
+	for i, step := range steps {
+		step.PreConfig()
+
+		if got, want := acctest.CurrentTestStep(t.Name()), i+1; got != want {
+			t.Errorf("step %d: current test step = %d, want %d", i, got, want)
+		}
+	}
+
+	if got, want := len(preConfigs), 1; got != want {
+		t.Fatalf("PreConfig called %d times, want %d", got, want)
+	}
+	if got, want := preConfigs[0], 2; got != want {
+		t.Errorf("PreConfig current test step = %d, want %d", got, want)
+	}
+
+	acctest.CloseVCRRecorder(t)
+
+	if got, want := acctest.CurrentTestStep(t.Name()), 0; got != want {
+		t.Errorf("current test step after close = %d, want %d", got, want)
+	}
+}
+
+func TestStepRecorder(t *testing.T) { //nolint:paralleltest
+	path := filepath.Join(t.TempDir(), "TestStepRecorder")
+	steps := acctest.VCRTestSteps(t, []resource.TestStep{{}, {}})
+	t.Cleanup(func() {
+		acctest.CloseVCRRecorder(t)
+	})
+
+	var n int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n++
+		fmt.Fprintf(w, "response %d", n)
+	}))
+
+	r := acctest.NewStepRecorder(context.Background(), recorder.ModeRecordOnly, path, http.DefaultTransport, t.Name())
+	for i, step := range steps {
+		step.PreConfig()
+
+		if got, want := stepRoundTrip(t, r, server.URL), fmt.Sprintf("response %d", i+1); got != want {
+			t.Errorf("RECORDING step %d: response = %q, want %q", i+1, got, want)
+		}
+	}
+	if err := r.Stop(); err != nil {
+		t.Fatalf("stopping recorder: %s", err)
+	}
+	server.Close()
+
+	for i := range steps {
+		if _, err := os.Stat(filepath.Join(path, fmt.Sprintf("step_%d.yaml", i+1))); err != nil {
+			t.Errorf("step %d cassette: %s", i+1, err)
+		}
+	}
+
+	r = acctest.NewStepRecorder(context.Background(), recorder.ModeReplayOnly, path, http.DefaultTransport, t.Name())
+	for i := len(steps) - 1; i >= 0; i-- {
+		steps[i].PreConfig()
+
+		if got, want := stepRoundTrip(t, r, server.URL), fmt.Sprintf("response %d", i+1); got != want {
+			t.Errorf("REPLAYING step %d: response = %q, want %q", i+1, got, want)
+		}
+	}
+	if err := r.Stop(); err != nil {
+		t.Fatalf("stopping recorder: %s", err)
+	}
+}
+
+func TestStepRecorderLegacyCassette(t *testing.T) { //nolint:paralleltest
+	path := filepath.Join(t.TempDir(), "TestStepRecorderLegacyCassette")
+	steps := acctest.VCRTestSteps(t, []resource.TestStep{{}, {}})
+	t.Cleanup(func() {
+		acctest.CloseVCRRecorder(t)
+	})
+
+	var n int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n++
+		fmt.Fprintf(w, "response %d", n)
+	}))
+
+	// Record a single cassette for all steps.
+	legacy, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:  path,
+		Mode:          recorder.ModeRecordOnly,
+		RealTransport: http.DefaultTransport,
+	})
+	if err != nil {
+		t.Fatalf("creating recorder: %s", err)
+	}
+	for range steps {
+		stepRoundTrip(t, legacy, server.URL)
+	}
+	if err := legacy.Stop(); err != nil {
+		t.Fatalf("stopping recorder: %s", err)
+	}
+	server.Close()
+
+	r := acctest.NewStepRecorder(context.Background(), recorder.ModeReplayOnly, path, http.DefaultTransport, t.Name())
+	for i, step := range steps {
+		step.PreConfig()
+
+		if got, want := stepRoundTrip(t, r, server.URL), fmt.Sprintf("response %d", i+1); got != want {
+			t.Errorf("REPLAYING step %d: response = %q, want %q", i+1, got, want)
+		}
+	}
+	if err := r.Stop(); err != nil {
+		t.Fatalf("stopping recorder: %s", err)
+	}
+}
+
+func stepRoundTrip(t *testing.T, transport http.RoundTripper, url string) string {
+	t.Helper()
+
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("creating request: %s", err)
+	}
+
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		t.Fatalf("sending request: %s", err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("reading response: %s", err)
+	}
+
+	return string(body)
+}
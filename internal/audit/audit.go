@@ -0,0 +1,101 @@
+// Package audit implements a machine-readable audit trail of the changes made by the provider.
+// Each resource CRUD operation is written as a single JSON object on its own line (JSON Lines)
+// together with the AWS API operations invoked during that CRUD operation.
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Resource CRUD operations.
+const (
+	OperationCreate = "Create"
+	OperationRead   = "Read"
+	OperationUpdate = "Update"
+	OperationDelete = "Delete"
+)
+
+// APICall represents a single AWS API operation invocation, including any retries.
+type APICall struct {
+	Service       string `json:"service"`
+	Operation     string `json:"operation"`
+	LatencyMillis int64  `json:"latency_ms"`
+	Retries       int    `json:"retries"`
+	ErrorCode     string `json:"error_code,omitempty"`
+}
+
+// Record represents a single resource CRUD operation.
+type Record struct {
+	Time           time.Time `json:"time"`
+	AccountID      string    `json:"account_id,omitempty"`
+	Region         string    `json:"region,omitempty"`
+	ResourceType   string    `json:"resource_type"`
+	Operation      string    `json:"operation"`
+	ID             string    `json:"id,omitempty"`
+	DurationMillis int64     `json:"duration_ms"`
+	APICalls       []APICall `json:"api_calls"`
+	ErrorCode      string    `json:"error_code,omitempty"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// Logger writes audit records to a file.
+type Logger struct {
+	file *os.File
+	lock sync.Mutex
+}
+
+// NewLogger returns a Logger that appends audit records to the specified file, creating it if necessary.
+func NewLogger(path string) (*Logger, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log (%s): %w", path, err)
+	}
+
+	return &Logger{file: file}, nil
+}
+
+// Write writes the specified record as a single line.
+func (l *Logger) Write(record Record) error {
+	if record.APICalls == nil {
+		record.APICalls = []APICall{}
+	}
+
+	b, err := json.Marshal(record)
+
+	if err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.file == nil {
+		return errors.New("audit log is closed")
+	}
+
+	_, err = l.file.Write(append(b, '\n'))
+
+	return err
+}
+
+// Close closes the audit log file.
+// Closing a closed Logger has no effect.
+func (l *Logger) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+
+	err := l.file.Close()
+	l.file = nil
+
+	return err
+}
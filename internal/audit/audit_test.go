@@ -0,0 +1,145 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoggerWrite(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	logger, err := NewLogger(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	records := []Record{
+		{
+			Time:         time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+			ResourceType: "aws_vpc",
+			Operation:    OperationCreate,
+			ID:           "vpc-12345678",
+			APICalls: []APICall{
+				{Service: "EC2", Operation: "CreateVpc", LatencyMillis: 100},
+				{Service: "EC2", Operation: "DescribeVpcs", LatencyMillis: 50, Retries: 1},
+			},
+		},
+		{
+			Time:         time.Date(2023, 6, 1, 0, 0, 1, 0, time.UTC),
+			ResourceType: "aws_vpc",
+			Operation:    OperationDelete,
+			ID:           "vpc-12345678",
+			APICalls: []APICall{
+				{Service: "EC2", Operation: "DeleteVpc", LatencyMillis: 100, ErrorCode: "DependencyViolation"},
+			},
+			ErrorCode: "DependencyViolation",
+			Error:     "deleting EC2 VPC (vpc-12345678): DependencyViolation",
+		},
+	}
+
+	for _, record := range records {
+		if err := logger.Write(record); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer file.Close()
+
+	var got []Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("unmarshaling %q: %s", scanner.Text(), err)
+		}
+		got = append(got, record)
+	}
+
+	if diff := cmp.Diff(got, records); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := logger.Close(); err != nil {
+			t.Errorf("closing: unexpected error: %s", err)
+		}
+	}
+
+	if err := logger.Write(records[0]); err == nil {
+		t.Error("writing to closed audit log: expected error")
+	}
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := FromContext(context.Background()); ok {
+		t.Error("expected no audit context")
+	}
+
+	ctx := NewContext(context.Background())
+	inContext, ok := FromContext(ctx)
+	if !ok {
+		t.Fatal("expected audit context")
+	}
+
+	inContext.addAPICall(APICall{Service: "STS", Operation: "GetCallerIdentity"})
+
+	if got, want := len(inContext.APICalls()), 1; got != want {
+		t.Errorf("length of APICalls() = %v, want %v", got, want)
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		apiCalls []APICall
+		expected string
+	}{
+		{
+			name:     "no calls",
+			expected: "",
+		},
+		{
+			name: "no errors",
+			apiCalls: []APICall{
+				{Service: "EC2", Operation: "CreateVpc"},
+			},
+			expected: "",
+		},
+		{
+			name: "last error",
+			apiCalls: []APICall{
+				{Service: "EC2", Operation: "CreateVpc", ErrorCode: "RequestLimitExceeded"},
+				{Service: "EC2", Operation: "CreateVpc", ErrorCode: "VpcLimitExceeded"},
+				{Service: "EC2", Operation: "DescribeVpcs"},
+			},
+			expected: "VpcLimitExceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ErrorCode(testCase.apiCalls), testCase.expected; got != want {
+				t.Errorf("ErrorCode() = %v, want %v", got, want)
+			}
+		})
+	}
+}
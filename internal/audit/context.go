@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type contextKeyType int
+
+var (
+	contextKey contextKeyType
+)
+
+// InContext represents the AWS API calls made during a resource CRUD operation.
+type InContext struct {
+	Start time.Time
+
+	apiCalls []APICall
+	lock     sync.Mutex
+}
+
+// APICalls returns the AWS API calls made so far.
+func (v *InContext) APICalls() []APICall {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	apiCalls := make([]APICall, len(v.apiCalls))
+	copy(apiCalls, v.apiCalls)
+
+	return apiCalls
+}
+
+func (v *InContext) addAPICall(apiCall APICall) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.apiCalls = append(v.apiCalls, apiCall)
+}
+
+func NewContext(ctx context.Context) context.Context {
+	v := InContext{
+		Start: time.Now(),
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
+func FromContext(ctx context.Context) (*InContext, bool) {
+	v, ok := ctx.Value(contextKey).(*InContext)
+	return v, ok
+}
+
+// SDKv1CompleteHandler is an AWS SDK for Go v1 request handler that records the API call in Context.
+// It should be added to the session's Complete handler list.
+func SDKv1CompleteHandler(r *request.Request) {
+	inContext, ok := FromContext(r.Context())
+	if !ok {
+		return
+	}
+
+	apiCall := APICall{
+		Service:       r.ClientInfo.ServiceID,
+		Operation:     r.Operation.Name,
+		LatencyMillis: time.Since(r.Time).Milliseconds(),
+		Retries:       r.RetryCount,
+	}
+
+	var awsErr awserr.Error
+	if errors.As(r.Error, &awsErr) {
+		apiCall.ErrorCode = awsErr.Code()
+	}
+
+	inContext.addAPICall(apiCall)
+}
+
+// SDKv2Middleware is an AWS SDK for Go v2 API option that records the API call in Context.
+// It should be added to the AWS configuration's API options.
+func SDKv2Middleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TFAWSAudit", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		inContext, ok := FromContext(ctx)
+		if !ok {
+			return next.HandleInitialize(ctx, in)
+		}
+
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		apiCall := APICall{
+			Service:       awsmiddleware.GetServiceID(ctx),
+			Operation:     awsmiddleware.GetOperationName(ctx),
+			LatencyMillis: time.Since(start).Milliseconds(),
+		}
+
+		if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+			apiCall.Retries = len(v.Results) - 1
+		}
+
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			apiCall.ErrorCode = apiErr.ErrorCode()
+		}
+
+		inContext.addAPICall(apiCall)
+
+		return out, metadata, err
+	}), middleware.After)
+}
+
+// ErrorCode returns the error code of the last failed AWS API call, if any.
+func ErrorCode(apiCalls []APICall) string {
+	for i := len(apiCalls) - 1; i >= 0; i-- {
+		if v := apiCalls[i].ErrorCode; v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,195 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	organizations_sdkv1 "github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"golang.org/x/exp/slices"
+)
+
+// AccountGuardConfig restricts the AWS accounts that the provider can be configured for.
+// Any forbidden match denies the account. If any allowed values are set for a property the account must match one of them.
+type AccountGuardConfig struct {
+	AllowedAccountIDs                []string
+	AllowedOrganizationIDs           []string
+	AllowedOrganizationalUnitPaths   []string
+	AllowedPartitions                []string
+	ForbiddenAccountIDs              []string
+	ForbiddenOrganizationIDs         []string
+	ForbiddenOrganizationalUnitPaths []string
+	ForbiddenPartitions              []string
+}
+
+// accountGuardTarget represents the properties of the AWS account that the provider is configured for.
+type accountGuardTarget struct {
+	accountID              string
+	organizationID         string
+	organizationalUnitPath string
+	partition              string
+}
+
+func (t *accountGuardTarget) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "account ID: %q, partition: %q", t.accountID, t.partition)
+	if t.organizationID != "" {
+		fmt.Fprintf(&b, ", organization ID: %q", t.organizationID)
+	}
+	if t.organizationalUnitPath != "" {
+		fmt.Fprintf(&b, ", organizational unit path: %q", t.organizationalUnitPath)
+	}
+
+	return b.String()
+}
+
+func (c *AccountGuardConfig) requiresOrganization() bool {
+	return len(c.AllowedOrganizationIDs) > 0 || len(c.ForbiddenOrganizationIDs) > 0 || c.requiresOrganizationalUnitPath()
+}
+
+func (c *AccountGuardConfig) requiresOrganizationalUnitPath() bool {
+	return len(c.AllowedOrganizationalUnitPaths) > 0 || len(c.ForbiddenOrganizationalUnitPaths) > 0
+}
+
+// check verifies that the AWS account the client is configured for satisfies the guard policy.
+func (c *AccountGuardConfig) check(ctx context.Context, client *AWSClient) diag.Diagnostics {
+	target := &accountGuardTarget{
+		accountID: client.AccountID,
+		partition: client.Partition,
+	}
+
+	if target.accountID == "" && (len(c.AllowedAccountIDs) > 0 || len(c.ForbiddenAccountIDs) > 0 || c.requiresOrganization()) {
+		return accountGuardDiags(target, "the AWS account ID could not be determined. The account guard cannot be used with `skip_requesting_account_id`")
+	}
+
+	if c.requiresOrganization() {
+		tflog.Debug(ctx, "Retrieving AWS Organizations details for account guard")
+		conn := client.OrganizationsConn(ctx)
+
+		output, err := conn.DescribeOrganizationWithContext(ctx, &organizations_sdkv1.DescribeOrganizationInput{})
+
+		switch {
+		case err == nil:
+			target.organizationID = aws_sdkv1.StringValue(output.Organization.Id)
+		case tfawserr.ErrCodeEquals(err, organizations_sdkv1.ErrCodeAWSOrganizationsNotInUseException):
+			// The account is not a member of an organization.
+		default:
+			return diag.Errorf("account guard: reading AWS Organizations organization: %s", err)
+		}
+
+		if target.organizationID != "" && c.requiresOrganizationalUnitPath() {
+			path, err := findOrganizationalUnitPath(ctx, conn, target.organizationID, target.accountID)
+
+			if err != nil {
+				return diag.Errorf("account guard: reading AWS Organizations organizational unit path for account (%s): %s", target.accountID, err)
+			}
+
+			target.organizationalUnitPath = path
+		}
+	}
+
+	tflog.Debug(ctx, "Checking account guard", map[string]any{
+		"tf_aws.account_guard.account_id":               target.accountID,
+		"tf_aws.account_guard.organization_id":          target.organizationID,
+		"tf_aws.account_guard.organizational_unit_path": target.organizationalUnitPath,
+		"tf_aws.account_guard.partition":                target.partition,
+	})
+
+	if reason := c.deny(target); reason != "" {
+		return accountGuardDiags(target, reason)
+	}
+
+	return nil
+}
+
+// deny returns the reason that the target is denied by the guard policy, or "" if the target is allowed.
+func (c *AccountGuardConfig) deny(target *accountGuardTarget) string {
+	equals := func(s string) func(string) bool {
+		return func(v string) bool {
+			return v == s
+		}
+	}
+	hasPrefix := func(s string) func(string) bool {
+		return func(v string) bool {
+			return s != "" && strings.HasPrefix(s, v)
+		}
+	}
+
+	for _, v := range []struct {
+		property  string
+		value     string
+		allowed   []string
+		forbidden []string
+		match     func(string) func(string) bool
+	}{
+		{"account ID", target.accountID, c.AllowedAccountIDs, c.ForbiddenAccountIDs, equals},
+		{"organization ID", target.organizationID, c.AllowedOrganizationIDs, c.ForbiddenOrganizationIDs, equals},
+		{"organizational unit path", target.organizationalUnitPath, c.AllowedOrganizationalUnitPaths, c.ForbiddenOrganizationalUnitPaths, hasPrefix},
+		{"partition", target.partition, c.AllowedPartitions, c.ForbiddenPartitions, equals},
+	} {
+		if i := slices.IndexFunc(v.forbidden, v.match(v.value)); i != -1 {
+			return fmt.Sprintf("the %s matches forbidden value %q", v.property, v.forbidden[i])
+		}
+
+		if len(v.allowed) > 0 && !slices.ContainsFunc(v.allowed, v.match(v.value)) {
+			if v.value == "" {
+				return fmt.Sprintf("the account has no %s and an allowed %s is required", v.property, v.property)
+			}
+
+			return fmt.Sprintf("the %s does not match any allowed value (%s)", v.property, strings.Join(v.allowed, ", "))
+		}
+	}
+
+	return ""
+}
+
+func accountGuardDiags(target *accountGuardTarget, reason string) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "AWS account not allowed by account guard",
+			Detail: fmt.Sprintf("The provider is configured for an AWS account (%s) that is not allowed by the `account_guard` configuration: %s.\n\n"+
+				"Check that the credentials in use are for the intended AWS account.", target, reason),
+		},
+	}
+}
+
+// findOrganizationalUnitPath returns the path of the account in the organization, in the same format as the aws:PrincipalOrgPaths IAM condition key,
+// e.g. "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/".
+func findOrganizationalUnitPath(ctx context.Context, conn *organizations_sdkv1.Organizations, organizationID, accountID string) (string, error) {
+	var ids []string
+
+	for childID := accountID; ; {
+		input := &organizations_sdkv1.ListParentsInput{
+			ChildId: aws_sdkv1.String(childID),
+		}
+
+		output, err := conn.ListParentsWithContext(ctx, input)
+
+		if err != nil {
+			return "", err
+		}
+
+		// There is only a single parent.
+		// https://docs.aws.amazon.com/organizations/latest/APIReference/API_ListParents.html
+		if output == nil || len(output.Parents) == 0 || output.Parents[0] == nil {
+			return "", fmt.Errorf("no parent found for %s", childID)
+		}
+
+		parent := output.Parents[0]
+		ids = append([]string{aws_sdkv1.StringValue(parent.Id)}, ids...)
+
+		if aws_sdkv1.StringValue(parent.Type) == organizations_sdkv1.ParentTypeRoot {
+			break
+		}
+
+		childID = aws_sdkv1.StringValue(parent.Id)
+	}
+
+	return fmt.Sprintf("%s/%s/", organizationID, strings.Join(ids, "/")), nil
+}
@@ -0,0 +1,143 @@
+package conns
+
+import (
+	"testing"
+)
+
+func TestAccountGuardConfigDeny(t *testing.T) {
+	t.Parallel()
+
+	target := &accountGuardTarget{
+		accountID:              "123456789012",
+		organizationID:         "o-a1b2c3d4e5",
+		organizationalUnitPath: "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/",
+		partition:              "aws",
+	}
+
+	testCases := []struct {
+		name     string
+		config   *AccountGuardConfig
+		target   *accountGuardTarget
+		expected bool
+	}{
+		{
+			name:     "empty",
+			config:   &AccountGuardConfig{},
+			target:   target,
+			expected: false,
+		},
+		{
+			name: "allowed account ID",
+			config: &AccountGuardConfig{
+				AllowedAccountIDs: []string{"111111111111", "123456789012"},
+			},
+			target:   target,
+			expected: false,
+		},
+		{
+			name: "not allowed account ID",
+			config: &AccountGuardConfig{
+				AllowedAccountIDs: []string{"111111111111"},
+			},
+			target:   target,
+			expected: true,
+		},
+		{
+			name: "forbidden account ID",
+			config: &AccountGuardConfig{
+				ForbiddenAccountIDs: []string{"123456789012"},
+			},
+			target:   target,
+			expected: true,
+		},
+		{
+			name: "not forbidden account ID",
+			config: &AccountGuardConfig{
+				ForbiddenAccountIDs: []string{"111111111111"},
+			},
+			target:   target,
+			expected: false,
+		},
+		{
+			name: "forbidden overrides allowed",
+			config: &AccountGuardConfig{
+				AllowedOrganizationIDs: []string{"o-a1b2c3d4e5"},
+				ForbiddenAccountIDs:    []string{"123456789012"},
+			},
+			target:   target,
+			expected: true,
+		},
+		{
+			name: "not allowed organization ID",
+			config: &AccountGuardConfig{
+				AllowedOrganizationIDs: []string{"o-zzzzzzzzzz"},
+			},
+			target:   target,
+			expected: true,
+		},
+		{
+			name: "allowed organization ID no organization",
+			config: &AccountGuardConfig{
+				AllowedOrganizationIDs: []string{"o-a1b2c3d4e5"},
+			},
+			target: &accountGuardTarget{
+				accountID: "123456789012",
+				partition: "aws",
+			},
+			expected: true,
+		},
+		{
+			name: "allowed organizational unit path",
+			config: &AccountGuardConfig{
+				AllowedOrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+			},
+			target:   target,
+			expected: false,
+		},
+		{
+			name: "not allowed organizational unit path",
+			config: &AccountGuardConfig{
+				AllowedOrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-33333333/"},
+			},
+			target:   target,
+			expected: true,
+		},
+		{
+			name: "forbidden organizational unit path",
+			config: &AccountGuardConfig{
+				ForbiddenOrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/"},
+			},
+			target:   target,
+			expected: true,
+		},
+		{
+			name: "allowed partition",
+			config: &AccountGuardConfig{
+				AllowedPartitions: []string{"aws"},
+			},
+			target:   target,
+			expected: false,
+		},
+		{
+			name: "forbidden partition",
+			config: &AccountGuardConfig{
+				ForbiddenPartitions: []string{"aws", "aws-cn"},
+			},
+			target:   target,
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			reason := testCase.config.deny(testCase.target)
+
+			if got, want := reason != "", testCase.expected; got != want {
+				t.Errorf("deny() = %q, want denied %t", reason, want)
+			}
+		})
+	}
+}
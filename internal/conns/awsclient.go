@@ -13,31 +13,39 @@ import (
 	apigatewayv2_sdkv1 "github.com/aws/aws-sdk-go/service/apigatewayv2"
 	mediaconvert_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconvert"
 	s3_sdkv1 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/audit"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
 	AccountID               string
+	AuditLogger             *audit.Logger
 	DefaultTagsConfig       *tftags.DefaultConfig
 	DNSSuffix               string
 	IgnoreTagsConfig        *tftags.IgnoreConfig
 	MediaConvertAccountConn *mediaconvert_sdkv1.MediaConvert
+	NormalizeTagsConfig     *tftags.NormalizeConfig
 	Partition               string
+	ReadOnly                bool
 	Region                  string
+	RequiredTagsConfig      *tftags.RequiredConfig
 	ReverseDNSPrefix        string
 	ServicePackages         map[string]ServicePackage
 	Session                 *session_sdkv1.Session
+	TaggingAPIMode          string
 	TerraformVersion        string
 
-	awsConfig      *aws_sdkv2.Config
-	clients        map[string]any
-	conns          map[string]any
-	endpoints      map[string]string // From provider configuration.
-	httpClient     *http.Client
-	lock           sync.Mutex
-	s3UsePathStyle bool   // From provider configuration.
-	stsRegion      string // From provider configuration.
+	awsConfig       *aws_sdkv2.Config
+	clients         map[string]any
+	conns           map[string]any
+	endpoints       map[string]string // From provider configuration.
+	httpClient      *http.Client
+	lock            sync.Mutex
+	rateLimiters    *rateLimiters
+	regionalClients map[string]*AWSClient // Keyed by Region.
+	s3UsePathStyle  bool                  // From provider configuration.
+	stsRegion       string                // From provider configuration.
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -139,6 +147,19 @@ func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]a
 		"partition":        client.Partition,
 		"session":          client.Session,
 	}
+	// The service's request rate limit is shared by its AWS SDK for Go v1 and v2 API clients.
+	if v := client.rateLimiters.get(servicePackageName, client.Region); v != nil {
+		if client.Session != nil {
+			sess := client.Session.Copy()
+			sess.Handlers.Sign.PushFrontNamed(v.sdkv1Handler())
+			m["session"] = sess
+		}
+		if client.awsConfig != nil {
+			cfg := client.awsConfig.Copy()
+			cfg.APIOptions = append(cfg.APIOptions[:len(cfg.APIOptions):len(cfg.APIOptions)], v.sdkv2Middleware)
+			m["aws_sdkv2_config"] = &cfg
+		}
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = client.s3UsePathStyle
@@ -149,8 +170,93 @@ func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]a
 	return m
 }
 
+// RegionalClient returns a client for the specified Region that shares this client's credentials and configuration.
+// The client itself is returned if the Region is empty or is the client's Region.
+func (client *AWSClient) RegionalClient(region string) *AWSClient {
+	if region == "" || region == client.Region {
+		return client
+	}
+
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	if v, ok := client.regionalClients[region]; ok {
+		return v
+	}
+
+	dnsSuffix, partition := "amazonaws.com", client.Partition
+	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), region); ok {
+		dnsSuffix, partition = p.DNSSuffix(), p.ID()
+	}
+
+	// The MediaConvert account endpoint is Region-specific, so it isn't shared.
+	regionalClient := &AWSClient{
+		AccountID:           client.AccountID,
+		AuditLogger:         client.AuditLogger,
+		DefaultTagsConfig:   client.DefaultTagsConfig,
+		DNSSuffix:           dnsSuffix,
+		IgnoreTagsConfig:    client.IgnoreTagsConfig,
+		NormalizeTagsConfig: client.NormalizeTagsConfig,
+		Partition:           partition,
+		ReadOnly:            client.ReadOnly,
+		Region:              region,
+		RequiredTagsConfig:  client.RequiredTagsConfig,
+		ReverseDNSPrefix:    ReverseDNS(dnsSuffix),
+		ServicePackages:     client.ServicePackages,
+		TaggingAPIMode:      client.TaggingAPIMode,
+		TerraformVersion:    client.TerraformVersion,
+
+		clients:        make(map[string]any, 0),
+		conns:          make(map[string]any, 0),
+		endpoints:      client.endpoints,
+		httpClient:     client.httpClient,
+		rateLimiters:   client.rateLimiters,
+		s3UsePathStyle: client.s3UsePathStyle,
+		stsRegion:      client.stsRegion,
+	}
+
+	if client.Session != nil {
+		regionalClient.Session = client.Session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+	}
+	if client.awsConfig != nil {
+		cfg := client.awsConfig.Copy()
+		cfg.Region = region
+		regionalClient.awsConfig = &cfg
+	}
+
+	if client.regionalClients == nil {
+		client.regionalClients = make(map[string]*AWSClient)
+	}
+	client.regionalClients[region] = regionalClient
+
+	return regionalClient
+}
+
+// DefaultTagsConfigForContext returns the default tags configuration applicable to the resource or data source in Context.
+// Any tag normalization is applied.
+func (client *AWSClient) DefaultTagsConfigForContext(ctx context.Context) *tftags.DefaultConfig {
+	defaultTagsConfig := client.DefaultTagsConfig
+
+	if v, ok := FromContext(ctx); ok {
+		defaultTagsConfig = defaultTagsConfig.ForResource(v.ServicePackageName, v.TypeName)
+	}
+
+	return defaultTagsConfig.Normalize(client.NormalizeTagsConfig)
+}
+
+// regionalClientFromContext returns the client for any Region override in Context.
+func (client *AWSClient) regionalClientFromContext(ctx context.Context) *AWSClient {
+	if v, ok := FromContext(ctx); ok {
+		return client.RegionalClient(v.Region)
+	}
+
+	return client
+}
+
 // conn returns the AWS SDK for Go v1 API client for the specified service.
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
+	c = c.regionalClientFromContext(ctx)
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -200,6 +306,8 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
+	c = c.regionalClientFromContext(ctx)
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -2,6 +2,8 @@ package conns
 
 import (
 	"testing"
+
+	mediaconvert_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconvert"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -87,3 +89,44 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientRegionalClient(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		AccountID: "123456789012",
+		DNSSuffix: "amazonaws.com",
+		Partition: "aws",
+		Region:    "us-west-2",
+
+		MediaConvertAccountConn: &mediaconvert_sdkv1.MediaConvert{},
+	}
+
+	if got := client.RegionalClient(""); got != client {
+		t.Errorf("got %p for empty Region, expected %p", got, client)
+	}
+	if got := client.RegionalClient("us-west-2"); got != client {
+		t.Errorf("got %p for client Region, expected %p", got, client)
+	}
+
+	regionalClient := client.RegionalClient("cn-north-1")
+
+	if got, expected := regionalClient.Region, "cn-north-1"; got != expected {
+		t.Errorf("got Region %s, expected %s", got, expected)
+	}
+	if got, expected := regionalClient.AccountID, client.AccountID; got != expected {
+		t.Errorf("got AccountID %s, expected %s", got, expected)
+	}
+	if got, expected := regionalClient.RegionalHostname("test"), "test.cn-north-1.amazonaws.com.cn"; got != expected {
+		t.Errorf("got RegionalHostname %s, expected %s", got, expected)
+	}
+	if got, expected := regionalClient.Partition, "aws-cn"; got != expected {
+		t.Errorf("got Partition %s, expected %s", got, expected)
+	}
+	if got := regionalClient.MediaConvertAccountConn; got != nil {
+		t.Errorf("got MediaConvertAccountConn %p, expected nil", got)
+	}
+	if got := client.RegionalClient("cn-north-1"); got != regionalClient {
+		t.Errorf("got %p for cached Region, expected %p", got, regionalClient)
+	}
+}
@@ -2,6 +2,7 @@ package conns
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
@@ -11,15 +12,22 @@ import (
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/audit"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 type Config struct {
 	AccessKey                      string
+	AccountGuardConfig             *AccountGuardConfig
 	AllowedAccountIds              []string
+	APIRecordingFile               string
+	APIRecordingMode               string
+	APIRateLimits                  map[string]float64
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogFile                   string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
@@ -31,8 +39,12 @@ type Config struct {
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	NormalizeTagsConfig            *tftags.NormalizeConfig
 	Profile                        string
+	ReadOnly                       bool
 	Region                         string
+	ReadOnlyAPI                    bool
+	RequiredTagsConfig             *tftags.RequiredConfig
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	SecretKey                      string
@@ -43,6 +55,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TaggingAPIMode                 string
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
@@ -51,6 +64,22 @@ type Config struct {
 
 // ConfigureProvider configures the provided provider Meta (instance data).
 func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWSClient, diag.Diagnostics) {
+	if c.APIRecordingMode != "" {
+		httpClient, err := c.apiRecordingHTTPClient(ctx, client.HTTPClient())
+		if err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+		client.SetHTTPClient(httpClient)
+
+		if c.APIRecordingMode == APIRecordingModeReplay {
+			// No real AWS API calls are made so credentials are not required.
+			c.AccessKey = apiRecordingReplayAccessKey
+			c.SecretKey = apiRecordingReplaySecretKey
+			c.Token = ""
+			c.EC2MetadataServiceEnableState = imds_sdkv2.ClientDisabled
+		}
+	}
+
 	awsbaseConfig := awsbase.Config{
 		AccessKey:                     c.AccessKey,
 		APNInfo:                       StdUserAgentProducts(c.TerraformVersion),
@@ -120,6 +149,25 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
+	if c.AuditLogFile != "" {
+		auditLogger, err := audit.NewLogger(c.AuditLogFile)
+		if err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+		client.AuditLogger = auditLogger
+		registerCloser(auditLogger)
+
+		// Record AWS API calls made during resource CRUD operations.
+		sess.Handlers.Complete.PushBack(audit.SDKv1CompleteHandler)
+		cfg.APIOptions = append(cfg.APIOptions, audit.SDKv2Middleware)
+	}
+
+	if c.ReadOnlyAPI {
+		// Refuse AWS API calls that may modify resources, however they are made.
+		sess.Handlers.Validate.PushFrontNamed(readOnlyAPISDKv1Handler)
+		cfg.APIOptions = append(cfg.APIOptions, readOnlyAPISDKv2Middleware)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
@@ -131,24 +179,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		log.Println("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
 
-	if len(c.ForbiddenAccountIds) > 0 {
-		for _, forbiddenAccountID := range c.AllowedAccountIds {
-			if accountID == forbiddenAccountID {
-				return nil, diag.Errorf("AWS account ID not allowed: %s", accountID)
-			}
-		}
-	}
-	if len(c.AllowedAccountIds) > 0 {
-		found := false
-		for _, allowedAccountID := range c.AllowedAccountIds {
-			if accountID == allowedAccountID {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return nil, diag.Errorf("AWS account ID not allowed: %s", accountID)
-		}
+	if err := c.checkAccountID(accountID); err != nil {
+		return nil, diag.FromErr(err)
 	}
 
 	DNSSuffix := "amazonaws.com"
@@ -160,11 +192,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
+	client.NormalizeTagsConfig = c.NormalizeTagsConfig
 	client.Partition = partition
+	client.ReadOnly = c.ReadOnly
 	client.Region = c.Region
+	client.RequiredTagsConfig = c.RequiredTagsConfig
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
+	client.TaggingAPIMode = c.TaggingAPIMode
 	client.TerraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
@@ -172,8 +208,28 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.rateLimiters = newRateLimiters(c.APIRateLimits)
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.stsRegion = c.STSRegion
 
+	if c.AccountGuardConfig != nil {
+		if diags := c.AccountGuardConfig.check(ctx, client); diags.HasError() {
+			return nil, diags
+		}
+	}
+
 	return client, nil
 }
+
+// checkAccountID returns an error if the specified account ID is forbidden or, when allowed account IDs are configured, not allowed.
+func (c *Config) checkAccountID(accountID string) error {
+	if slices.Contains(c.ForbiddenAccountIds, accountID) {
+		return fmt.Errorf("AWS account ID not allowed: %s", accountID)
+	}
+
+	if len(c.AllowedAccountIds) > 0 && !slices.Contains(c.AllowedAccountIds, accountID) {
+		return fmt.Errorf("AWS account ID not allowed: %s", accountID)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package conns
+
+import (
+	"testing"
+)
+
+func TestConfigCheckAccountID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		config      *Config
+		accountID   string
+		expectError bool
+	}{
+		{
+			name:      "no restrictions",
+			config:    &Config{},
+			accountID: "123456789012",
+		},
+		{
+			name: "allowed",
+			config: &Config{
+				AllowedAccountIds: []string{"111111111111", "123456789012"},
+			},
+			accountID: "123456789012",
+		},
+		{
+			name: "not allowed",
+			config: &Config{
+				AllowedAccountIds: []string{"111111111111"},
+			},
+			accountID:   "123456789012",
+			expectError: true,
+		},
+		{
+			name: "forbidden",
+			config: &Config{
+				ForbiddenAccountIds: []string{"111111111111", "123456789012"},
+			},
+			accountID:   "123456789012",
+			expectError: true,
+		},
+		{
+			name: "not forbidden",
+			config: &Config{
+				ForbiddenAccountIds: []string{"111111111111"},
+			},
+			accountID: "123456789012",
+		},
+		{
+			name: "allowed and forbidden",
+			config: &Config{
+				AllowedAccountIds:   []string{"123456789012"},
+				ForbiddenAccountIds: []string{"123456789012"},
+			},
+			accountID:   "123456789012",
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.config.checkAccountID(testCase.accountID)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("checkAccountID(%q) err = %v, want error %v", testCase.accountID, err, want)
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ package conns
 
 import (
 	"context"
+	"errors"
+	"io"
 	"strings"
+	"sync"
 
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
@@ -33,24 +36,28 @@ var (
 // InContext represents the resource information kept in Context.
 type InContext struct {
 	IsDataSource       bool   // Data source?
+	Region             string // AWS Region that the resource is in if overridden, e.g. "eu-west-1"
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
+	TypeName           string // Terraform type name, e.g. "aws_subnet"
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -95,3 +102,35 @@ func ReverseDNS(hostname string) string {
 
 	return strings.Join(parts, ".")
 }
+
+var (
+	closersLock sync.Mutex
+	closers     []io.Closer
+)
+
+// registerCloser registers a resource opened while configuring the provider, such as an API recording, to be closed when the provider stops.
+func registerCloser(closer io.Closer) {
+	closersLock.Lock()
+	defer closersLock.Unlock()
+
+	closers = append(closers, closer)
+}
+
+// Close closes all the resources opened while configuring the provider.
+// It is called when the provider stops.
+func Close() error {
+	closersLock.Lock()
+	defer closersLock.Unlock()
+
+	var errs []error
+
+	for _, closer := range closers {
+		if err := closer.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	closers = nil
+
+	return errors.Join(errs...)
+}
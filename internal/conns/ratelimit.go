@@ -0,0 +1,166 @@
+package conns
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// rateLimiter is a token bucket limiting the rate of AWS API requests.
+// The bucket holds at most burst tokens and is refilled at rate tokens per second.
+type rateLimiter struct {
+	burst  float64
+	last   time.Time
+	lock   sync.Mutex
+	rate   float64
+	tokens float64
+}
+
+// newRateLimiter returns a rateLimiter allowing the specified number of requests per second.
+func newRateLimiter(rate float64) *rateLimiter {
+	burst := math.Max(1, math.Ceil(rate))
+
+	return &rateLimiter{
+		burst:  burst,
+		last:   time.Now(),
+		rate:   rate,
+		tokens: burst,
+	}
+}
+
+// wait blocks until a request can be made or the Context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.lock.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	// Reserve a token. A negative token count represents requests already waiting.
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.lock.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		// Return the reserved token.
+		l.lock.Lock()
+		l.tokens++
+		l.lock.Unlock()
+
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// sdkv1Handler returns an AWS SDK for Go v1 request handler that waits for the rate limiter.
+// It should be added at the front of the Sign handler list so that it runs for each request attempt.
+func (l *rateLimiter) sdkv1Handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "tf-aws.RateLimit",
+		Fn: func(r *request.Request) {
+			if err := l.wait(r.Context()); err != nil {
+				r.Error = err
+			}
+		},
+	}
+}
+
+// sdkv2Middleware is an AWS SDK for Go v2 API option that waits for the rate limiter.
+// The middleware is added to the Finalize step so that it runs for each request attempt.
+func (l *rateLimiter) sdkv2Middleware(stack *middleware.Stack) error {
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("TFAWSRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if err := l.wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+
+		return next.HandleFinalize(ctx, in)
+	}), middleware.After)
+}
+
+// globalServices are the service packages whose resources and API request quotas are per account rather than per Region.
+var globalServices = map[string]bool{
+	names.CloudFront:        true,
+	names.GlobalAccelerator: true,
+	names.IAM:               true,
+	names.Organizations:     true,
+	names.Route53:           true,
+	names.Shield:            true,
+	names.WAF:               true,
+}
+
+// IsGlobalService returns whether the specified service package's resources are per account rather than per Region.
+func IsGlobalService(servicePackageName string) bool {
+	return globalServices[servicePackageName]
+}
+
+// rateLimiters are the rate limiters of the service packages that have a request rate limit.
+// Regional services have a rate limiter per Region, global services have a single rate limiter.
+type rateLimiters struct {
+	limiters map[string]*rateLimiter // Keyed by service package name and, for regional services, Region.
+	lock     sync.Mutex
+	rates    map[string]float64 // Keyed by service package name.
+}
+
+// newRateLimiters returns the rate limiters for each service package that has a request rate limit.
+// Configured limits, keyed by service package name, override the defaults in names_data.csv.
+func newRateLimiters(limits map[string]float64) *rateLimiters {
+	rates := make(map[string]float64)
+
+	for _, servicePackageName := range names.ProviderPackages() {
+		rate := names.RateLimit(servicePackageName)
+
+		if v, ok := limits[servicePackageName]; ok {
+			rate = v
+		}
+
+		if rate > 0 {
+			rates[servicePackageName] = rate
+		}
+	}
+
+	return &rateLimiters{
+		limiters: make(map[string]*rateLimiter),
+		rates:    rates,
+	}
+}
+
+// get returns the rate limiter for the specified service package in the specified Region,
+// or nil if the service package has no request rate limit.
+func (l *rateLimiters) get(servicePackageName, region string) *rateLimiter {
+	if l == nil {
+		return nil
+	}
+
+	rate, ok := l.rates[servicePackageName]
+	if !ok {
+		return nil
+	}
+
+	key := servicePackageName
+	if !globalServices[servicePackageName] {
+		key += "/" + region
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	v, ok := l.limiters[key]
+	if !ok {
+		v = newRateLimiter(rate)
+		l.limiters[key] = v
+	}
+
+	return v
+}
@@ -0,0 +1,152 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newRateLimiter(20)
+
+	start := time.Now()
+	// The first 20 requests are allowed immediately, the next 10 are spread over 0.5 seconds.
+	for i := 0; i < 30; i++ {
+		if err := l.wait(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	elapsed := time.Since(start)
+
+	if lower, upper := 450*time.Millisecond, 2*time.Second; elapsed < lower || elapsed > upper {
+		t.Errorf("elapsed = %s, want between %s and %s", elapsed, lower, upper)
+	}
+}
+
+func TestRateLimiterWaitContextDone(t *testing.T) {
+	t.Parallel()
+
+	l := newRateLimiter(0.1)
+
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := l.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestNewRateLimiters(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		limits   map[string]float64
+		expected map[string]float64
+	}{
+		{
+			name: "defaults",
+			expected: map[string]float64{
+				names.IAM:           10,
+				names.Organizations: 5,
+				names.Route53:       5,
+			},
+		},
+		{
+			name: "override default",
+			limits: map[string]float64{
+				names.Route53: 2,
+			},
+			expected: map[string]float64{
+				names.IAM:           10,
+				names.Organizations: 5,
+				names.Route53:       2,
+			},
+		},
+		{
+			name: "additional",
+			limits: map[string]float64{
+				names.EC2: 20,
+			},
+			expected: map[string]float64{
+				names.EC2:           20,
+				names.IAM:           10,
+				names.Organizations: 5,
+				names.Route53:       5,
+			},
+		},
+		{
+			name: "remove default",
+			limits: map[string]float64{
+				names.IAM: 0,
+			},
+			expected: map[string]float64{
+				names.Organizations: 5,
+				names.Route53:       5,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			rateLimiters := newRateLimiters(testCase.limits)
+
+			if got, want := len(rateLimiters.rates), len(testCase.expected); got != want {
+				t.Errorf("length of rate limits = %v, want %v", got, want)
+			}
+
+			for k, want := range testCase.expected {
+				v := rateLimiters.get(k, "us-west-2")
+				if v == nil {
+					t.Errorf("no rate limiter for %s", k)
+					continue
+				}
+
+				if got := v.rate; got != want {
+					t.Errorf("rate for %s = %v, want %v", k, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitersGet(t *testing.T) {
+	t.Parallel()
+
+	l := newRateLimiters(map[string]float64{
+		names.EC2: 20,
+	})
+
+	if v := l.get(names.S3, "us-west-2"); v != nil {
+		t.Errorf("unexpected rate limiter for %s", names.S3)
+	}
+
+	if l.get(names.EC2, "us-west-2") != l.get(names.EC2, "us-west-2") {
+		t.Errorf("rate limiters for %s in the same Region differ", names.EC2)
+	}
+
+	if l.get(names.EC2, "us-west-2") == l.get(names.EC2, "us-east-1") {
+		t.Errorf("rate limiters for %s in different Regions are shared", names.EC2)
+	}
+
+	if l.get(names.IAM, "us-west-2") != l.get(names.IAM, "us-east-1") {
+		t.Errorf("rate limiters for global service %s in different Regions differ", names.IAM)
+	}
+
+	if v := (*rateLimiters)(nil).get(names.IAM, "us-west-2"); v != nil {
+		t.Errorf("unexpected rate limiter from nil rate limiters")
+	}
+}
@@ -0,0 +1,77 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// readOnlyOperationPrefixes are the name prefixes of AWS API operations that do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// ReadOnlyAPIError is returned for AWS API calls refused because the client only allows read-only operations.
+type ReadOnlyAPIError struct {
+	Service   string
+	Operation string
+}
+
+func (e *ReadOnlyAPIError) Error() string {
+	return fmt.Sprintf("AWS API call %s.%s is not allowed, only read-only operations are allowed", e.Service, e.Operation)
+}
+
+// isReadOnlyOperation returns whether the named AWS API operation does not modify resources.
+func isReadOnlyOperation(operation string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// readOnlyAPISDKv1Handler is an AWS SDK for Go v1 request handler that fails calls to operations that may modify resources.
+// It should be added to the Validate handler list so that the request is never sent.
+var readOnlyAPISDKv1Handler = request.NamedHandler{
+	Name: "tf-aws.ReadOnlyAPI",
+	Fn: func(r *request.Request) {
+		if r.Operation == nil || isReadOnlyOperation(r.Operation.Name) {
+			return
+		}
+
+		r.Error = &ReadOnlyAPIError{
+			Service:   r.ClientInfo.ServiceID,
+			Operation: r.Operation.Name,
+		}
+	},
+}
+
+// readOnlyAPISDKv2Middleware is an AWS SDK for Go v2 API option that fails calls to operations that may modify resources.
+// The middleware is added to the Initialize step so that the request is never sent or retried.
+func readOnlyAPISDKv2Middleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TFAWSReadOnlyAPI", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if operation := awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(operation) {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, &ReadOnlyAPIError{
+				Service:   awsmiddleware.GetServiceID(ctx),
+				Operation: operation,
+			}
+		}
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
@@ -0,0 +1,82 @@
+package conns_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/faultinjection"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+)
+
+func TestReadOnlyAPI(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	t.Setenv("AWS_CA_BUNDLE", "")
+
+	transport := faultinjection.NewTransport(faultinjection.Scenario{
+		{Service: "iam", Operation: "GetRole", Fault: faultinjection.Response(`<GetRoleResponse><GetRoleResult><Role><RoleName>test</RoleName></Role></GetRoleResult></GetRoleResponse>`)},
+	}, nil)
+
+	client := &conns.AWSClient{
+		ServicePackages: map[string]conns.ServicePackage{},
+	}
+	for _, sp := range []conns.ServicePackage{tfiam.ServicePackage(ctx), tfsesv2.ServicePackage(ctx)} {
+		client.ServicePackages[sp.ServicePackageName()] = sp
+	}
+	client.SetHTTPClient(&http.Client{Transport: transport})
+
+	config := &conns.Config{
+		AccessKey:                     "READONLY",
+		EC2MetadataServiceEnableState: imds_sdkv2.ClientDisabled,
+		ReadOnlyAPI:                   true,
+		Region:                        "us-west-2",
+		SecretKey:                     "READONLY",
+		SkipCredsValidation:           true,
+		SkipRegionValidation:          true,
+		SkipRequestingAccountId:       true,
+	}
+
+	client, diags := config.ConfigureProvider(ctx, client)
+
+	if err := sdkdiag.DiagnosticsError(diags); err != nil {
+		t.Fatal(err)
+	}
+
+	var target *conns.ReadOnlyAPIError
+
+	if _, err := client.IAMConn(ctx).GetRoleWithContext(ctx, &iam.GetRoleInput{RoleName: aws.String("test")}); err != nil {
+		t.Errorf("AWS SDK for Go v1 read: unexpected error: %s", err)
+	}
+
+	if _, err := client.IAMConn(ctx).DeleteRoleWithContext(ctx, &iam.DeleteRoleInput{RoleName: aws.String("test")}); !errors.As(err, &target) {
+		t.Errorf("AWS SDK for Go v1 delete: err = %v, want %T", err, target)
+	}
+
+	if _, err := client.SESV2Client(ctx).DeleteContactList(ctx, &sesv2.DeleteContactListInput{ContactListName: aws.String("test")}); !errors.As(err, &target) {
+		t.Errorf("AWS SDK for Go v2 delete: err = %v, want %T", err, target)
+	}
+
+	// Only the read reaches the transport, nothing is deleted.
+	if got, want := transport.Requests(), []string{"iam.GetRole"}; len(got) != len(want) || got[0] != want[0] {
+		t.Errorf("requests = %v, want %v", got, want)
+	}
+}
+
+func TestReadOnlyAPIError(t *testing.T) {
+	t.Parallel()
+
+	err := &conns.ReadOnlyAPIError{Service: "EC2", Operation: "DeleteVpc"}
+
+	if got, want := err.Error(), "AWS API call EC2.DeleteVpc is not allowed, only read-only operations are allowed"; got != want {
+		t.Errorf("Error() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,121 @@
+package conns
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+// API recording modes.
+const (
+	APIRecordingModeRecord = "record"
+	APIRecordingModeReplay = "replay"
+)
+
+func APIRecordingMode_Values() []string {
+	return []string{
+		APIRecordingModeRecord,
+		APIRecordingModeReplay,
+	}
+}
+
+const (
+	// Placeholder static credentials used when replaying recorded AWS API interactions.
+	apiRecordingReplayAccessKey = "REPLAYING"
+	apiRecordingReplaySecretKey = "REPLAYING"
+)
+
+// apiRecordingHTTPClient returns an HTTP client that records AWS API interactions to, or replays them from, the configured file.
+// Any existing HTTP client's transport is used for real requests.
+func (c *Config) apiRecordingHTTPClient(ctx context.Context, httpClient *http.Client) (*http.Client, error) {
+	var mode recorder.Mode
+	switch c.APIRecordingMode {
+	case APIRecordingModeRecord:
+		mode = recorder.ModeRecordOnly
+	case APIRecordingModeReplay:
+		mode = recorder.ModeReplayOnly
+	default:
+		return nil, fmt.Errorf("unsupported API recording mode: %s", c.APIRecordingMode)
+	}
+
+	if c.APIRecordingFile == "" {
+		return nil, fmt.Errorf("API recording mode (%s) requires a recording file", c.APIRecordingMode)
+	}
+
+	if httpClient == nil {
+		var err error
+		httpClient, err = c.apiRecordingRealHTTPClient()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tflog.Info(ctx, "AWS API recording enabled", map[string]any{
+		"tf_aws.api_recording.mode": c.APIRecordingMode,
+		"tf_aws.api_recording.file": c.APIRecordingFile,
+	})
+
+	// The recorder always adds a ".yaml" extension to the cassette name.
+	r, err := vcr.NewPersistentRecorder(ctx, strings.TrimSuffix(c.APIRecordingFile, ".yaml"), mode, httpClient.Transport)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening API recording (%s): %w", c.APIRecordingFile, err)
+	}
+
+	registerCloser(r)
+
+	return &http.Client{
+		CheckRedirect: httpClient.CheckRedirect,
+		Jar:           httpClient.Jar,
+		Timeout:       httpClient.Timeout,
+		Transport:     r,
+	}, nil
+}
+
+// apiRecordingRealHTTPClient returns the HTTP client used to make real requests when recording.
+// Providing an HTTP client to aws-sdk-go-base bypasses its handling of the custom CA bundle, insecure and HTTP proxy settings
+// so they are applied here.
+func (c *Config) apiRecordingRealHTTPClient() (*http.Client, error) {
+	httpClient := vcr.NewHTTPClient()
+	transport := httpClient.Transport.(*http.Transport)
+
+	if c.Insecure {
+		transport.TLSClientConfig.InsecureSkipVerify = true
+	}
+
+	if c.CustomCABundle != "" {
+		pem, err := os.ReadFile(c.CustomCABundle)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading custom CA bundle (%s): %w", c.CustomCABundle, err)
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("custom CA bundle (%s) contains no certificates", c.CustomCABundle)
+		}
+
+		transport.TLSClientConfig.RootCAs = certPool
+	}
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing HTTP proxy URL (%s): %w", c.HTTPProxy, err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return httpClient, nil
+}
@@ -0,0 +1,123 @@
+package conns
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAPIRecordingHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "recording.yaml")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		io.WriteString(w, "<Credentials><SecretAccessKey>secret</SecretAccessKey></Credentials>") //nolint:errcheck
+	}))
+
+	config := &Config{
+		APIRecordingFile: file,
+		APIRecordingMode: APIRecordingModeRecord,
+	}
+	httpClient, err := config.apiRecordingHTTPClient(ctx, server.Client())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	request, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	request.Header.Set("Authorization", "AWS4-HMAC-SHA256 Signature=abc")
+	response, err := httpClient.Do(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	response.Body.Close()
+
+	// The recording is persisted when the recorder is closed.
+	if err := httpClient.Transport.(io.Closer).Close(); err != nil {
+		t.Fatalf("closing recorder: %s", err)
+	}
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("reading recording: %s", err)
+	}
+	if s := string(b); strings.Contains(s, "secret") || strings.Contains(s, "Signature=abc") {
+		t.Errorf("recording contains sensitive data: %s", s)
+	}
+
+	server.Close()
+
+	config.APIRecordingMode = APIRecordingModeReplay
+	httpClient, err = config.apiRecordingHTTPClient(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Interactions can be replayed more than once.
+	for i := 0; i < 2; i++ {
+		request, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		response, err := httpClient.Do(request)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		b, _ := io.ReadAll(response.Body)
+		response.Body.Close()
+
+		if got, want := string(b), "<Credentials><SecretAccessKey>REDACTED</SecretAccessKey></Credentials>"; got != want {
+			t.Errorf("replayed body = %s, want %s", got, want)
+		}
+	}
+
+	request, _ = http.NewRequestWithContext(ctx, http.MethodPost, server.URL, nil)
+	if _, err := httpClient.Do(request); err == nil {
+		t.Error("expected error for unrecorded interaction")
+	}
+}
+
+func TestAPIRecordingHTTPClientInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		config *Config
+	}{
+		{
+			name: "invalid mode",
+			config: &Config{
+				APIRecordingFile: "recording.yaml",
+				APIRecordingMode: "invalid",
+			},
+		},
+		{
+			name: "no file",
+			config: &Config{
+				APIRecordingMode: APIRecordingModeRecord,
+			},
+		},
+		{
+			name: "replay missing file",
+			config: &Config{
+				APIRecordingFile: filepath.Join(t.TempDir(), "missing"),
+				APIRecordingMode: APIRecordingModeReplay,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := testCase.config.apiRecordingHTTPClient(context.Background(), nil); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
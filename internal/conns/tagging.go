@@ -0,0 +1,56 @@
+package conns
+
+import (
+	"context"
+
+	arn_sdkv1 "github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Resource Groups Tagging API modes.
+const (
+	// Resources are tagged using the Resource Groups Tagging API only if their service package has no generic UpdateTags method.
+	TaggingAPIModeFallback = "fallback"
+	// Resources are always tagged using the Resource Groups Tagging API.
+	TaggingAPIModeAlways = "always"
+)
+
+func TaggingAPIMode_Values() []string {
+	return []string{
+		TaggingAPIModeFallback,
+		TaggingAPIModeAlways,
+	}
+}
+
+// TagsServicePackage returns the service package whose generic ListTags and UpdateTags methods
+// are used for the resource with the specified identifier.
+// Depending on the provider's Resource Groups Tagging API mode, resources identified by ARN
+// use the Resource Groups Tagging API service package instead of their own service package.
+func (client *AWSClient) TagsServicePackage(sp ServicePackage, identifier string) ServicePackage {
+	if client.TaggingAPIMode == "" || !arn_sdkv1.IsARN(identifier) {
+		return sp
+	}
+
+	taggingAPI, ok := client.ServicePackages[names.ResourceGroupsTaggingAPI]
+	if !ok {
+		return sp
+	}
+
+	switch client.TaggingAPIMode {
+	case TaggingAPIModeAlways:
+		return taggingAPI
+	case TaggingAPIModeFallback:
+		switch sp.(type) {
+		case interface {
+			UpdateTags(context.Context, any, string, any, any) error
+		}, interface {
+			UpdateTags(context.Context, any, string, string, any, any) error
+		}:
+			return sp
+		}
+
+		return taggingAPI
+	}
+
+	return sp
+}
@@ -0,0 +1,112 @@
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type testServicePackage struct {
+	name string
+}
+
+func (sp *testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (sp *testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (sp *testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (sp *testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return nil
+}
+
+func (sp *testServicePackage) ServicePackageName() string {
+	return sp.name
+}
+
+type testTaggingServicePackage struct {
+	testServicePackage
+}
+
+func (sp *testTaggingServicePackage) UpdateTags(context.Context, any, string, any, any) error {
+	return nil
+}
+
+func TestAWSClientTagsServicePackage(t *testing.T) {
+	t.Parallel()
+
+	taggingAPI := &testServicePackage{name: names.ResourceGroupsTaggingAPI}
+	withoutUpdateTags := &testServicePackage{name: "test1"}
+	withUpdateTags := &testTaggingServicePackage{testServicePackage{name: "test2"}}
+	servicePackages := map[string]ServicePackage{
+		names.ResourceGroupsTaggingAPI: taggingAPI,
+	}
+	arn := "arn:aws:test:us-west-2:123456789012:thing/example" //lintignore:AWSAT003,AWSAT005
+
+	testCases := []struct {
+		name       string
+		mode       string
+		sp         ServicePackage
+		identifier string
+		want       ServicePackage
+	}{
+		{
+			name:       "no mode",
+			sp:         withoutUpdateTags,
+			identifier: arn,
+			want:       withoutUpdateTags,
+		},
+		{
+			name:       "fallback without UpdateTags",
+			mode:       TaggingAPIModeFallback,
+			sp:         withoutUpdateTags,
+			identifier: arn,
+			want:       taggingAPI,
+		},
+		{
+			name:       "fallback with UpdateTags",
+			mode:       TaggingAPIModeFallback,
+			sp:         withUpdateTags,
+			identifier: arn,
+			want:       withUpdateTags,
+		},
+		{
+			name:       "always",
+			mode:       TaggingAPIModeAlways,
+			sp:         withUpdateTags,
+			identifier: arn,
+			want:       taggingAPI,
+		},
+		{
+			name:       "not an ARN",
+			mode:       TaggingAPIModeAlways,
+			sp:         withUpdateTags,
+			identifier: "thing-1234",
+			want:       withUpdateTags,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				ServicePackages: servicePackages,
+				TaggingAPIMode:  testCase.mode,
+			}
+
+			if got, want := client.TagsServicePackage(testCase.sp, testCase.identifier), testCase.want; got != want {
+				t.Errorf("TagsServicePackage = %s, want %s", got.ServicePackageName(), want.ServicePackageName())
+			}
+		})
+	}
+}
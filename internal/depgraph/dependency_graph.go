@@ -182,6 +182,44 @@ func (g *Graph) OverallOrder() ([]string, error) {
 	return order, nil
 }
 
+// Waves returns the processing order for the dependency graph grouped into waves.
+// Each node is in the wave following the last of its dependencies, so the nodes in a wave can be processed concurrently
+// once all previous waves are complete. Within a wave, nodes are in the order they were added to the graph.
+// Returns an error if a dependency cycle is detected.
+func (g *Graph) Waves() ([][]string, error) {
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	waves := make([][]string, 0)
+	wave := make(map[string]int)
+
+	for len(wave) < g.Len() {
+		current := make([]string, 0)
+
+		for _, node := range g.nodes {
+			if _, ok := wave[node]; ok {
+				continue
+			}
+
+			if tfslices.All(g.outgoingEdges[node], func(s string) bool {
+				w, ok := wave[s]
+				return ok && w < len(waves)
+			}) {
+				current = append(current, node)
+			}
+		}
+
+		for _, node := range current {
+			wave[node] = len(waves)
+		}
+
+		waves = append(waves, current)
+	}
+
+	return waves, nil
+}
+
 // depthFirstSearch returns a Topological Sort using Depth-First-Search on a set of edges.
 // Returns an error if a dependency cycle is detected.
 func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error) {
@@ -414,3 +414,58 @@ func TestDependencyGraphOverallOrder(t *testing.T) {
 		t.Fatalf("incorrect overall order. Expected: %v, got: %v", expected, got)
 	}
 }
+
+func TestDependencyGraphWaves(t *testing.T) {
+	t.Parallel()
+
+	g := New()
+
+	got, err := g.Waves()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := [][]string{}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("incorrect waves. Expected: %v, got: %v", expected, got)
+	}
+
+	g.AddNode("a")
+	g.AddNode("b")
+	g.AddNode("c")
+	g.AddNode("d")
+	g.AddNode("e")
+
+	err = g.AddDependency("a", "b")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("a", "c")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("b", "c")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("b", "d")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err = g.Waves()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := [][]string{{"c", "d", "e"}, {"b"}, {"a"}}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("incorrect waves. Expected: %v, got: %v", expected, got)
+	}
+
+	err = g.AddDependency("c", "a")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = g.Waves()
+	if err == nil {
+		t.Fatalf("expected dependency cycle error")
+	}
+}
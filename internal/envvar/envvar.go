@@ -81,6 +81,31 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to control resource sweepers
+const (
+	// Whether sweepers write an inventory of the resources they would delete instead of deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// The file that the sweeper dry-run inventory is written to.
+	// Defaults to standard output.
+	SweepInventoryFile = "TF_AWS_SWEEP_INVENTORY_FILE"
+
+	// The minimum age, e.g. "6h", of resources that sweepers delete
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// The tag, "key" or "key=value", that resources must have to be deleted by sweepers
+	SweepOwnershipTag = "TF_AWS_SWEEP_OWNERSHIP_TAG"
+)
+
+// Custom environment variables used to configure the provider
+const (
+	// The file that AWS API interactions are recorded to or replayed from
+	APIRecordingFile = "TF_AWS_API_RECORDING_FILE"
+
+	// Whether AWS API interactions are recorded ("record") or replayed ("replay")
+	APIRecordingMode = "TF_AWS_API_RECORDING_MODE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,51 @@
+package faultinjection
+
+import (
+	"context"
+	"net/http"
+
+	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+const (
+	// Placeholder static credentials. No real AWS API calls are made.
+	accessKey = "FAULTINJECTION"
+	secretKey = "FAULTINJECTION"
+)
+
+// NewAWSClient returns an AWSClient whose AWS API calls are handled by a new Transport for the specified Scenario.
+// API clients can only be created for the specified service packages.
+// Requests that are not responded to by the Scenario fail. The AWS SDKs retry throttling and 5xx errors up to 3 times.
+// The AWS_CA_BUNDLE environment variable must not be set as it cannot be applied to the Transport.
+func NewAWSClient(ctx context.Context, scenario Scenario, servicePackages ...conns.ServicePackage) (*conns.AWSClient, *Transport, error) {
+	transport := NewTransport(scenario, nil)
+
+	client := &conns.AWSClient{
+		ServicePackages: make(map[string]conns.ServicePackage, len(servicePackages)),
+	}
+	for _, sp := range servicePackages {
+		client.ServicePackages[sp.ServicePackageName()] = sp
+	}
+	client.SetHTTPClient(&http.Client{Transport: transport})
+
+	config := &conns.Config{
+		AccessKey:                     accessKey,
+		EC2MetadataServiceEnableState: imds_sdkv2.ClientDisabled,
+		MaxRetries:                    3,
+		Region:                        "us-west-2",
+		SecretKey:                     secretKey,
+		SkipCredsValidation:           true,
+		SkipRegionValidation:          true,
+		SkipRequestingAccountId:       true,
+	}
+
+	client, diags := config.ConfigureProvider(ctx, client)
+
+	if err := sdkdiag.DiagnosticsError(diags); err != nil {
+		return nil, nil, err
+	}
+
+	return client, transport, nil
+}
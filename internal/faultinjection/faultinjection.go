@@ -0,0 +1,286 @@
+// Package faultinjection contains an HTTP transport that injects faults into AWS API calls according to a scripted scenario.
+// It is used to test retries and waiters offline, e.g. that a resource survives IAM propagation delays.
+package faultinjection
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+// A Fault describes how a request is handled.
+type Fault struct {
+	// Latency is the delay before the request is handled.
+	Latency time.Duration
+	// StatusCode is the HTTP status code of the injected response.
+	// If zero, no response is injected and the request is handled by the next matching Step, if any, after the latency.
+	StatusCode int
+	// Code and Message are the AWS error code and message of an injected error response (status code 300 or above).
+	Code    string
+	Message string
+	// Body is the body of an injected successful response.
+	Body string
+}
+
+// Throttling returns a Fault that injects a throttling error.
+func Throttling() Fault {
+	return Fault{
+		StatusCode: http.StatusBadRequest,
+		Code:       "Throttling",
+		Message:    "Rate exceeded",
+	}
+}
+
+// InternalError returns a Fault that injects an internal server error.
+func InternalError() Fault {
+	return Fault{
+		StatusCode: http.StatusInternalServerError,
+		Code:       "InternalFailure",
+		Message:    "The request processing has failed because of an unknown error, exception or failure.",
+	}
+}
+
+// ServiceUnavailable returns a Fault that injects a service unavailable error.
+func ServiceUnavailable() Fault {
+	return Fault{
+		StatusCode: http.StatusServiceUnavailable,
+		Code:       "ServiceUnavailable",
+		Message:    "The request has failed due to a temporary failure of the server.",
+	}
+}
+
+// NotFound returns a Fault that injects the specified "not found" error, e.g. an eventually consistent read of a new resource.
+func NotFound(code string) Fault {
+	return Fault{
+		StatusCode: http.StatusNotFound,
+		Code:       code,
+		Message:    "The specified resource was not found.",
+	}
+}
+
+// Error returns a Fault that injects the specified client error.
+func Error(code, message string) Fault {
+	return Fault{
+		StatusCode: http.StatusBadRequest,
+		Code:       code,
+		Message:    message,
+	}
+}
+
+// Latency returns a Fault that delays the request by the specified duration.
+func Latency(d time.Duration) Fault {
+	return Fault{
+		Latency: d,
+	}
+}
+
+// Response returns a Fault that injects a successful response with the specified body.
+func Response(body string) Fault {
+	return Fault{
+		StatusCode: http.StatusOK,
+		Body:       body,
+	}
+}
+
+// A Step applies a Fault to a number of requests.
+type Step struct {
+	// Service is the service's signing name, e.g. "iam". If empty, requests to any service match.
+	Service string
+	// Operation is the API operation name, e.g. "GetRole". If empty, requests for any operation match.
+	// The operation name is not available for REST protocol requests and such requests only match an empty Operation.
+	Operation string
+	// Times is the number of matching requests the Fault is applied to. If zero, it is applied to all matching requests.
+	Times int
+	Fault Fault
+}
+
+// A Scenario is an ordered list of Steps.
+// Each request is handled by the first matching Step that has not yet been applied Times times.
+// Requests not responded to by any Step are passed to the next transport.
+type Scenario []Step
+
+// Transport is an http.RoundTripper that injects faults according to a Scenario.
+type Transport struct {
+	lock     sync.Mutex
+	next     http.RoundTripper
+	requests []string
+	scenario Scenario
+	used     []int
+}
+
+// NewTransport returns a Transport for the specified Scenario.
+// Requests that are not responded to by the Scenario are sent using next. If next is nil such requests fail.
+func NewTransport(scenario Scenario, next http.RoundTripper) *Transport {
+	return &Transport{
+		next:     next,
+		scenario: scenario,
+		used:     make([]int, len(scenario)),
+	}
+}
+
+// NewHTTPClient returns an HTTP client using a new Transport for the specified Scenario.
+// Use conns.AWSClient.SetHTTPClient before configuring the provider to inject faults into AWS API calls.
+func NewHTTPClient(scenario Scenario, next http.RoundTripper) *http.Client {
+	return &http.Client{
+		Transport: NewTransport(scenario, next),
+	}
+}
+
+// Requests returns the requests made, in order, as "service.Operation" strings.
+func (t *Transport) Requests() []string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return append([]string{}, t.requests...)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	service, operation, err := vcr.RequestOperation(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	fault, ok := t.fault(service, operation)
+
+	if fault.Latency > 0 {
+		timer := time.NewTimer(fault.Latency)
+		defer timer.Stop()
+
+		select {
+		case <-r.Context().Done():
+			return nil, r.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	if ok {
+		return newResponse(r, service, fault), nil
+	}
+
+	if t.next == nil {
+		return nil, fmt.Errorf("no response scripted for %s.%s", service, operation)
+	}
+
+	return t.next.RoundTrip(r)
+}
+
+// fault records the request and returns the Fault for the matching Steps.
+// The latencies of matching Steps that inject no response are added to the Fault of the first matching Step that does.
+func (t *Transport) fault(service, operation string) (Fault, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.requests = append(t.requests, service+"."+operation)
+
+	var latency time.Duration
+
+	for i, step := range t.scenario {
+		if step.Service != "" && step.Service != service {
+			continue
+		}
+
+		if step.Operation != "" && step.Operation != operation {
+			continue
+		}
+
+		if step.Times > 0 && t.used[i] >= step.Times {
+			continue
+		}
+
+		t.used[i]++
+		latency += step.Fault.Latency
+
+		if step.Fault.StatusCode != 0 {
+			fault := step.Fault
+			fault.Latency = latency
+
+			return fault, true
+		}
+	}
+
+	return Fault{Latency: latency}, false
+}
+
+// newResponse returns an injected response in the request's protocol.
+func newResponse(r *http.Request, service string, fault Fault) *http.Response {
+	const requestID = "00000000-0000-0000-0000-000000000000"
+
+	header := http.Header{}
+	header.Set("X-Amzn-Requestid", requestID)
+
+	body := fault.Body
+
+	if fault.StatusCode >= http.StatusMultipleChoices {
+		contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+		switch {
+		case r.Header.Get("X-Amz-Target") != "" || strings.HasPrefix(contentType, "application/x-amz-json-"):
+			header.Set("Content-Type", "application/x-amz-json-1.1")
+			body = jsonError(fault, false)
+		case contentType == "application/x-www-form-urlencoded" && service == "ec2":
+			header.Set("Content-Type", "text/xml")
+			body = fmt.Sprintf(`<Response><Errors><Error><Code>%[1]s</Code><Message>%[2]s</Message></Error></Errors><RequestID>%[3]s</RequestID></Response>`, xmlEscape(fault.Code), xmlEscape(fault.Message), requestID)
+		case contentType == "application/x-www-form-urlencoded":
+			header.Set("Content-Type", "text/xml")
+			body = fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%[1]s</Code><Message>%[2]s</Message></Error><RequestId>%[3]s</RequestId></ErrorResponse>`, xmlEscape(fault.Code), xmlEscape(fault.Message), requestID)
+		case service == "s3":
+			header.Set("Content-Type", "application/xml")
+			body = fmt.Sprintf(`<Error><Code>%[1]s</Code><Message>%[2]s</Message><RequestId>%[3]s</RequestId></Error>`, xmlEscape(fault.Code), xmlEscape(fault.Message), requestID)
+		case service == "cloudfront" || service == "route53":
+			header.Set("Content-Type", "application/xml")
+			body = fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%[1]s</Code><Message>%[2]s</Message></Error><RequestId>%[3]s</RequestId></ErrorResponse>`, xmlEscape(fault.Code), xmlEscape(fault.Message), requestID)
+		default:
+			// REST-JSON protocol.
+			header.Set("Content-Type", "application/json")
+			header.Set("X-Amzn-Errortype", fault.Code)
+			body = jsonError(fault, true)
+		}
+	}
+
+	header.Set("Content-Length", fmt.Sprint(len(body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fault.StatusCode, http.StatusText(fault.StatusCode)),
+		StatusCode:    fault.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       r,
+	}
+}
+
+func jsonError(fault Fault, rest bool) string {
+	v := map[string]string{
+		"message": fault.Message,
+	}
+	if rest {
+		v["code"] = fault.Code
+	} else {
+		v["__type"] = fault.Code
+	}
+
+	b, _ := json.Marshal(v)
+
+	return string(b)
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+
+	_ = xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
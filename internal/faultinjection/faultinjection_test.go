@@ -0,0 +1,285 @@
+package faultinjection_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/faultinjection"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	getRoleResponse = `<GetRoleResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <GetRoleResult>
+    <Role>
+      <Path>/</Path>
+      <RoleName>test</RoleName>
+      <RoleId>AROAEXAMPLEEXAMPLEEXA</RoleId>
+      <Arn>arn:aws:iam::123456789012:role/test</Arn>
+      <CreateDate>2023-01-01T00:00:00Z</CreateDate>
+    </Role>
+  </GetRoleResult>
+  <ResponseMetadata>
+    <RequestId>00000000-0000-0000-0000-000000000000</RequestId>
+  </ResponseMetadata>
+</GetRoleResponse>`
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func newQueryRequest(t *testing.T, service, action string) *http.Request {
+	t.Helper()
+
+	body := url.Values{"Action": []string{action}}.Encode()
+	r, err := http.NewRequest(http.MethodPost, "https://"+service+".amazonaws.com/", strings.NewReader(body))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	return r
+}
+
+func newAWSClient(ctx context.Context, t *testing.T, scenario faultinjection.Scenario) (*conns.AWSClient, *faultinjection.Transport) {
+	t.Helper()
+
+	t.Setenv("AWS_CA_BUNDLE", "")
+
+	client, transport, err := faultinjection.NewAWSClient(ctx, scenario, tfiam.ServicePackage(ctx))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client, transport
+}
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+
+	var passedThrough int
+	next := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		passedThrough++
+
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+
+	transport := faultinjection.NewTransport(faultinjection.Scenario{
+		{Service: "iam", Operation: "GetRole", Times: 2, Fault: faultinjection.NotFound("NoSuchEntity")},
+		{Service: "iam", Operation: "GetRole", Times: 1, Fault: faultinjection.Throttling()},
+		{Service: "sqs", Fault: faultinjection.InternalError()},
+	}, next)
+
+	testCases := []struct {
+		service, action string
+		wantStatusCode  int
+	}{
+		{"iam", "GetRole", http.StatusNotFound},
+		{"iam", "GetUser", http.StatusOK},
+		{"iam", "GetRole", http.StatusNotFound},
+		{"iam", "GetRole", http.StatusBadRequest},
+		{"iam", "GetRole", http.StatusOK},
+		{"sqs", "GetQueueUrl", http.StatusInternalServerError},
+		{"sqs", "GetQueueUrl", http.StatusInternalServerError},
+	}
+
+	for i, testCase := range testCases {
+		response, err := transport.RoundTrip(newQueryRequest(t, testCase.service, testCase.action))
+
+		if err != nil {
+			t.Fatalf("request %d: unexpected error: %s", i, err)
+		}
+
+		if got, want := response.StatusCode, testCase.wantStatusCode; got != want {
+			t.Errorf("request %d: status code: got %d, want %d", i, got, want)
+		}
+	}
+
+	if got, want := passedThrough, 2; got != want {
+		t.Errorf("requests passed through: got %d, want %d", got, want)
+	}
+
+	if got, want := transport.Requests(), []string{
+		"iam.GetRole",
+		"iam.GetUser",
+		"iam.GetRole",
+		"iam.GetRole",
+		"iam.GetRole",
+		"sqs.GetQueueUrl",
+		"sqs.GetQueueUrl",
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("requests: got %v, want %v", got, want)
+	}
+}
+
+func TestTransport_noResponse(t *testing.T) {
+	t.Parallel()
+
+	transport := faultinjection.NewTransport(faultinjection.Scenario{
+		{Fault: faultinjection.Latency(time.Millisecond)},
+	}, nil)
+
+	if _, err := transport.RoundTrip(newQueryRequest(t, "iam", "GetRole")); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestTransport_latency(t *testing.T) {
+	t.Parallel()
+
+	transport := faultinjection.NewTransport(faultinjection.Scenario{
+		{Fault: faultinjection.Latency(time.Hour)},
+	}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := transport.RoundTrip(newQueryRequest(t, "iam", "GetRole").WithContext(ctx))
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context deadline exceeded, got %v", err)
+	}
+}
+
+func TestAWSClient_sdkRetries(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	client, transport := newAWSClient(ctx, t, faultinjection.Scenario{
+		{Service: "iam", Operation: "GetRole", Times: 1, Fault: faultinjection.Throttling()},
+		{Service: "iam", Operation: "GetRole", Times: 1, Fault: faultinjection.ServiceUnavailable()},
+		{Service: "iam", Operation: "GetRole", Times: 1, Fault: faultinjection.InternalError()},
+		{Service: "iam", Operation: "GetRole", Fault: faultinjection.Response(getRoleResponse)},
+	})
+
+	role, err := tfiam.FindRoleByName(ctx, client.IAMConn(ctx), "test")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(transport.Requests()), 4; got != want {
+		t.Errorf("requests: got %d, want %d", got, want)
+	}
+
+	if got, want := *role.RoleName, "test"; got != want {
+		t.Errorf("role name: got %s, want %s", got, want)
+	}
+}
+
+func TestAWSClient_retryWhenNotFound(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	testCases := []struct {
+		name        string
+		scenario    faultinjection.Scenario
+		timeout     time.Duration
+		expectError bool
+	}{
+		{
+			name: "propagation delay",
+			scenario: faultinjection.Scenario{
+				{Service: "iam", Operation: "GetRole", Times: 2, Fault: faultinjection.NotFound(iam.ErrCodeNoSuchEntityException)},
+				{Service: "iam", Operation: "GetRole", Fault: faultinjection.Response(getRoleResponse)},
+			},
+			timeout: 5 * time.Second,
+		},
+		{
+			name: "never found",
+			scenario: faultinjection.Scenario{
+				{Service: "iam", Operation: "GetRole", Fault: faultinjection.NotFound(iam.ErrCodeNoSuchEntityException)},
+			},
+			timeout:     500 * time.Millisecond,
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:paralleltest
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			client, _ := newAWSClient(ctx, t, testCase.scenario)
+			conn := client.IAMConn(ctx)
+
+			_, err := tfresource.RetryWhenNotFound(ctx, testCase.timeout, func() (interface{}, error) {
+				return tfiam.FindRoleByName(ctx, conn, "test")
+			})
+
+			if testCase.expectError {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected not found error, got %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestAWSClient_retryWhenAWSErrCodeEquals(t *testing.T) { //nolint:paralleltest // nosemgrep:ci.aws-in-func-name
+	ctx := context.Background()
+
+	client, _ := newAWSClient(ctx, t, faultinjection.Scenario{
+		{Service: "iam", Operation: "GetRole", Times: 1, Fault: faultinjection.Error(iam.ErrCodeConcurrentModificationException, "Rate exceeded")},
+		{Service: "iam", Operation: "GetRole", Times: 1, Fault: faultinjection.Error(iam.ErrCodeInvalidInputException, "Invalid")},
+	})
+
+	conn := client.IAMConn(ctx)
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 5*time.Second, func() (interface{}, error) {
+		return tfiam.FindRoleByName(ctx, conn, "test")
+	}, iam.ErrCodeConcurrentModificationException)
+
+	if !tfawserr.ErrCodeEquals(err, iam.ErrCodeInvalidInputException) {
+		t.Fatalf("expected %s error, got %v", iam.ErrCodeInvalidInputException, err)
+	}
+}
+
+func TestAWSClient_waitUntil(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	client, transport := newAWSClient(ctx, t, faultinjection.Scenario{
+		{Service: "iam", Operation: "GetRole", Times: 1, Fault: faultinjection.NotFound(iam.ErrCodeNoSuchEntityException)},
+		{Service: "iam", Operation: "GetRole", Times: 1, Fault: faultinjection.Latency(50 * time.Millisecond)},
+		{Service: "iam", Operation: "GetRole", Fault: faultinjection.Response(getRoleResponse)},
+	})
+
+	conn := client.IAMConn(ctx)
+
+	err := tfresource.WaitUntil(ctx, 5*time.Second, func() (bool, error) {
+		_, err := tfiam.FindRoleByName(ctx, conn, "test")
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}, tfresource.WaitOpts{MinTimeout: 10 * time.Millisecond})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The latency step passes the request to the next matching step.
+	if got, want := len(transport.Requests()), 2; got != want {
+		t.Errorf("requests: got %d, want %d", got, want)
+	}
+}
@@ -59,8 +59,9 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 		return
 	}
 
-	defaultTagsConfig := r.Meta().DefaultTagsConfig
+	defaultTagsConfig := r.Meta().DefaultTagsConfigForContext(ctx)
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
+	normalizeTagsConfig := r.Meta().NormalizeTagsConfig
 
 	var planTags types.Map
 
@@ -73,7 +74,16 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	if !planTags.IsUnknown() {
 		if !mapHasUnknownElements(planTags) {
 			resourceTags := tftags.New(ctx, planTags)
-			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+
+			if inContext, ok := conns.FromContext(ctx); ok {
+				if err := r.Meta().RequiredTagsConfig.Check(inContext.TypeName, defaultTagsConfig.MergeTags(resourceTags).Normalize(normalizeTagsConfig)); err != nil {
+					response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Required tags", err.Error())
+
+					return
+				}
+			}
+
+			allTags := defaultTagsConfig.MergeTags(resourceTags).Normalize(normalizeTagsConfig).IgnoreConfig(ignoreTagsConfig)
 
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 		} else {
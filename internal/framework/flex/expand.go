@@ -4,10 +4,15 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // Expand "expands" a resource's "business logic" data structure,
@@ -16,6 +21,11 @@ import (
 // The resource's data structure is walked and exported fields that
 // have a corresponding field in the API data structure (and a suitable
 // target data type) are copied.
+// Fields are matched by name, see findFieldFuzzy, and an `autoflex:"Name"`
+// struct tag can be used to map a field to a differently named field.
+// Lists and Sets of Objects (nested blocks) are expanded into slices of structs
+// or, for a single element, a struct. Object attributes are matched to struct fields
+// by name, ignoring case and underscores.
 func Expand(ctx context.Context, tfObject, apiObject any) error {
 	if err := walkStructFields(ctx, tfObject, apiObject, expandVisitor{}); err != nil {
 		return fmt.Errorf("Expand[%T, %T]: %w", tfObject, apiObject, err)
@@ -54,7 +64,7 @@ func walkStructFields(ctx context.Context, from any, to any, visitor fieldVisito
 		if fieldName == "Tags" {
 			continue // Resource tags are handled separately.
 		}
-		toFieldVal := valTo.FieldByName(fieldName)
+		toFieldVal := findFieldFuzzy(field, valTo)
 		if !toFieldVal.IsValid() {
 			continue // Corresponding field not found in to.
 		}
@@ -69,6 +79,119 @@ func walkStructFields(ctx context.Context, from any, to any, visitor fieldVisito
 	return nil
 }
 
+// fieldNameMatcher matches field names.
+type fieldNameMatcher struct {
+	match       func(string, string) bool
+	collections bool // Only match fields that are both collections?
+}
+
+// fieldNameMatchers are applied in order when looking for the field in a struct corresponding to a given field name.
+var fieldNameMatchers = []fieldNameMatcher{
+	{match: func(a, b string) bool { return a == b }},
+	{match: strings.EqualFold},                  // e.g. "Arn" and "ARN".
+	{match: pluralEqualFold, collections: true}, // e.g. "SecurityGroupId" and "SecurityGroupIds".
+}
+
+// matches reports whether the field named `nameFrom` matches the field named `nameTo` of type `typTo`.
+// `collectionFrom` indicates whether the `nameFrom` field is a collection.
+func (m fieldNameMatcher) matches(nameFrom string, collectionFrom bool, nameTo string, typTo reflect.Type) bool {
+	if m.collections && !(collectionFrom && isCollectionType(typTo)) {
+		return false
+	}
+
+	return m.match(nameFrom, nameTo)
+}
+
+// findFieldFuzzy returns the exported field of struct `valTo` corresponding to the field `fieldFrom`.
+// Field names are taken from any `autoflex` struct tag, with a tag value of "-" excluding the field.
+// An exact name match is preferred, then a case-insensitive match and finally a plural or singular match between collections.
+func findFieldFuzzy(fieldFrom reflect.StructField, valTo reflect.Value) reflect.Value {
+	nameFrom, ok := autoFlexFieldName(fieldFrom)
+	if !ok {
+		return reflect.Value{}
+	}
+
+	typTo := valTo.Type()
+
+	for _, matcher := range fieldNameMatchers {
+		for i := 0; i < typTo.NumField(); i++ {
+			fieldTo := typTo.Field(i)
+			if fieldTo.PkgPath != "" {
+				continue // Skip unexported fields.
+			}
+			if nameTo, ok := autoFlexFieldName(fieldTo); ok && matcher.matches(nameFrom, isCollectionType(fieldFrom.Type), nameTo, fieldTo.Type) {
+				return valTo.Field(i)
+			}
+		}
+	}
+
+	return reflect.Value{}
+}
+
+// autoFlexFieldName returns the name used to match `field` against fields of another struct.
+// Returns false if the field is excluded from AutoFlex.
+func autoFlexFieldName(field reflect.StructField) (string, bool) {
+	switch name := field.Tag.Get("autoflex"); name {
+	case "":
+		return field.Name, true
+	case "-":
+		return "", false
+	default:
+		return name, true
+	}
+}
+
+// pluralEqualFold reports whether `a` and `b` are equal, ignoring case, once one or the other is pluralized.
+func pluralEqualFold(a, b string) bool {
+	return strings.EqualFold(names.Plural(a), b) || strings.EqualFold(a, names.Plural(b))
+}
+
+// isCollectionType reports whether `typ` is a Plugin Framework List or Set, a slice or an AWS API Items/Quantity wrapper struct.
+func isCollectionType(typ reflect.Type) bool {
+	if typ.Implements(reflect.TypeOf((*basetypes.ListValuable)(nil)).Elem()) || typ.Implements(reflect.TypeOf((*basetypes.SetValuable)(nil)).Elem()) {
+		return true
+	}
+
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	return typ.Kind() == reflect.Slice || isItemsQuantityWrapper(typ)
+}
+
+// isItemsQuantityWrapper reports whether `typ` is an AWS API wrapper struct, as used by CloudFront,
+// whose only exported fields are an `Items` slice and an integer `Quantity`.
+func isItemsQuantityWrapper(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Struct {
+		return false
+	}
+
+	var hasItems, hasQuantity bool
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+
+		fieldTyp := field.Type
+		if fieldTyp.Kind() == reflect.Ptr {
+			fieldTyp = fieldTyp.Elem()
+		}
+
+		switch {
+		case field.Name == "Items" && fieldTyp.Kind() == reflect.Slice:
+			hasItems = true
+		case field.Name == "Quantity" && (fieldTyp.Kind() == reflect.Int32 || fieldTyp.Kind() == reflect.Int64):
+			hasQuantity = true
+		default:
+			return false
+		}
+	}
+
+	return hasItems && hasQuantity
+}
+
 type fieldVisitor interface {
 	visit(context.Context, string, reflect.Value, reflect.Value) error
 }
@@ -81,12 +204,101 @@ func (v expandVisitor) visit(ctx context.Context, fieldName string, valFrom, val
 		return fmt.Errorf("does not implement attr.Value: %s", valFrom.Kind())
 	}
 
+	return expandValue(ctx, vFrom, valTo)
+}
+
+// expandValue copies the Plugin Framework value `vFrom` into the AWS API value `valTo`.
+// Nested objects, lists, sets and maps are expanded recursively.
+func expandValue(ctx context.Context, vFrom attr.Value, valTo reflect.Value) error {
 	// No need to set the target value if there's no source value.
 	if vFrom.IsNull() || vFrom.IsUnknown() {
 		return nil
 	}
 
 	tFrom, kTo := vFrom.Type(ctx), valTo.Kind()
+
+	// Custom types.
+	switch vFrom := vFrom.(type) {
+	case fwtypes.ARN:
+		if setString(valTo, vFrom.ValueARN().String()) {
+			return nil
+		}
+
+	case fwtypes.CIDRBlock:
+		if setString(valTo, vFrom.ValueCIDRBlock()) {
+			return nil
+		}
+
+	case fwtypes.Duration:
+		switch valTo.Type() {
+		case reflect.TypeOf(time.Duration(0)):
+			valTo.Set(reflect.ValueOf(vFrom.ValueDuration()))
+			return nil
+		case reflect.TypeOf((*time.Duration)(nil)):
+			valTo.Set(reflect.ValueOf(aws.Duration(vFrom.ValueDuration())))
+			return nil
+		}
+		if setString(valTo, vFrom.ValueDuration().String()) {
+			return nil
+		}
+
+	case fwtypes.TimestampValue:
+		switch valTo.Type() {
+		case reflect.TypeOf(time.Time{}):
+			valTo.Set(reflect.ValueOf(vFrom.ValueTimestamp()))
+			return nil
+		case reflect.TypeOf((*time.Time)(nil)):
+			valTo.Set(reflect.ValueOf(aws.Time(vFrom.ValueTimestamp())))
+			return nil
+		}
+		if setString(valTo, vFrom.ValueString()) {
+			return nil
+		}
+
+		// Aggregate types.
+	case types.List:
+		return expandElements(ctx, tFrom, vFrom.Elements(), valTo)
+
+	case types.Set:
+		return expandElements(ctx, tFrom, vFrom.Elements(), valTo)
+
+	case types.Map:
+		if kTo == reflect.Map && valTo.Type().Key().Kind() == reflect.String {
+			m := reflect.MakeMapWithSize(valTo.Type(), len(vFrom.Elements()))
+
+			for k, v := range vFrom.Elements() {
+				val := reflect.New(valTo.Type().Elem()).Elem()
+
+				if err := expandValue(ctx, v, val); err != nil {
+					return fmt.Errorf("key (%s): %w", k, err)
+				}
+
+				m.SetMapIndex(reflect.ValueOf(k).Convert(valTo.Type().Key()), val)
+			}
+
+			valTo.Set(m)
+			return nil
+		}
+
+	case types.Object:
+		switch kTo {
+		case reflect.Struct:
+			return expandObject(ctx, vFrom.Attributes(), valTo)
+
+		case reflect.Ptr:
+			if tElem := valTo.Type().Elem(); tElem.Kind() == reflect.Struct {
+				val := reflect.New(tElem)
+
+				if err := expandObject(ctx, vFrom.Attributes(), val.Elem()); err != nil {
+					return err
+				}
+
+				valTo.Set(val)
+				return nil
+			}
+		}
+	}
+
 	switch {
 	// Simple types.
 	case tFrom.Equal(types.BoolType):
@@ -138,56 +350,137 @@ func (v expandVisitor) visit(ctx context.Context, fieldName string, valFrom, val
 		}
 
 	case tFrom.Equal(types.StringType):
-		vFrom := vFrom.(types.String).ValueString()
-		switch kTo {
-		case reflect.String:
-			valTo.SetString(vFrom)
+		// Also handles string-typed enums.
+		if setString(valTo, vFrom.(types.String).ValueString()) {
 			return nil
-		case reflect.Ptr:
-			switch valTo.Type().Elem().Kind() {
-			case reflect.String:
-				valTo.Set(reflect.ValueOf(aws.String(vFrom)))
-				return nil
-			}
 		}
+	}
 
-		// Aggregate types.
-	case tFrom.Equal(types.ListType{ElemType: types.StringType}):
-		vFrom := vFrom.(types.List)
-		switch kTo {
-		case reflect.Slice:
-			switch tSliceElem := valTo.Type().Elem(); tSliceElem.Kind() {
-			case reflect.String:
-				valTo.Set(reflect.ValueOf(ExpandFrameworkStringValueList(ctx, vFrom)))
-				return nil
+	return fmt.Errorf("incompatible (%s): %s", tFrom, kTo)
+}
 
-			case reflect.Ptr:
-				switch tSliceElem.Elem().Kind() {
-				case reflect.String:
-					valTo.Set(reflect.ValueOf(ExpandFrameworkStringList(ctx, vFrom)))
-					return nil
-				}
+// expandElements copies the elements of a Plugin Framework List or Set into the AWS API value `valTo`.
+// `valTo` is either a slice or, for a nested block with at most one element, a struct or pointer to struct.
+func expandElements(ctx context.Context, tFrom attr.Type, elems []attr.Value, valTo reflect.Value) error {
+	switch kTo := valTo.Kind(); kTo {
+	case reflect.Slice:
+		s := reflect.MakeSlice(valTo.Type(), len(elems), len(elems))
+
+		for i, elem := range elems {
+			if err := expandValue(ctx, elem, s.Index(i)); err != nil {
+				return fmt.Errorf("element (%d): %w", i, err)
 			}
 		}
 
-	case tFrom.Equal(types.SetType{ElemType: types.StringType}):
-		vFrom := vFrom.(types.Set)
-		switch kTo {
-		case reflect.Slice:
-			switch tSliceElem := valTo.Type().Elem(); tSliceElem.Kind() {
-			case reflect.String:
-				valTo.Set(reflect.ValueOf(ExpandFrameworkStringValueSet(ctx, vFrom)))
-				return nil
+		valTo.Set(s)
+		return nil
 
-			case reflect.Ptr:
-				switch tSliceElem.Elem().Kind() {
-				case reflect.String:
-					valTo.Set(reflect.ValueOf(ExpandFrameworkStringSet(ctx, vFrom)))
-					return nil
-				}
+	case reflect.Struct, reflect.Ptr:
+		if kTo == reflect.Ptr && valTo.Type().Elem().Kind() != reflect.Struct {
+			break
+		}
+
+		if typ := valTo.Type(); isItemsQuantityWrapper(typ) || (kTo == reflect.Ptr && isItemsQuantityWrapper(typ.Elem())) {
+			return expandItemsQuantity(ctx, tFrom, elems, valTo)
+		}
+
+		switch n := len(elems); n {
+		case 0:
+			return nil
+		case 1:
+			return expandValue(ctx, elems[0], valTo)
+		default:
+			return fmt.Errorf("too many elements (%d) for %s", n, valTo.Type())
+		}
+	}
+
+	return fmt.Errorf("incompatible (%s): %s", tFrom, valTo.Kind())
+}
+
+// expandItemsQuantity copies the elements of a Plugin Framework List or Set into the AWS API `{Items, Quantity}` wrapper struct `valTo`.
+func expandItemsQuantity(ctx context.Context, tFrom attr.Type, elems []attr.Value, valTo reflect.Value) error {
+	typ := valTo.Type()
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	val := reflect.New(typ)
+
+	if err := expandElements(ctx, tFrom, elems, val.Elem().FieldByName("Items")); err != nil {
+		return fmt.Errorf("Items: %w", err)
+	}
+
+	quantity := val.Elem().FieldByName("Quantity")
+	if quantity.Kind() == reflect.Ptr {
+		quantity.Set(reflect.New(quantity.Type().Elem()))
+		quantity = quantity.Elem()
+	}
+	quantity.SetInt(int64(len(elems)))
+
+	if valTo.Kind() == reflect.Ptr {
+		valTo.Set(val)
+	} else {
+		valTo.Set(val.Elem())
+	}
+
+	return nil
+}
+
+// expandObject copies the attributes of a Plugin Framework Object into the AWS API struct `valTo`.
+func expandObject(ctx context.Context, attrs map[string]attr.Value, valTo reflect.Value) error {
+	for name, v := range attrs {
+		toFieldVal := fieldByAttributeName(valTo, name, isCollectionType(reflect.TypeOf(v)))
+		if !toFieldVal.IsValid() {
+			continue // Corresponding field not found in to.
+		}
+		if !toFieldVal.CanSet() {
+			continue // Corresponding field value can't be changed.
+		}
+		if err := expandValue(ctx, v, toFieldVal); err != nil {
+			return fmt.Errorf("attribute (%s): %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// fieldByAttributeName returns the exported field of struct `val` corresponding to the Terraform attribute `name`.
+// The field name is matched case-insensitively with any underscores removed from the attribute name,
+// e.g. `instance_type` matches `InstanceType`, falling back to a plural or singular match if the attribute is a `collection`.
+func fieldByAttributeName(val reflect.Value, name string, collection bool) reflect.Value {
+	name = strings.ReplaceAll(name, "_", "")
+	typ := val.Type()
+
+	for _, matcher := range fieldNameMatchers[1:] {
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if field.PkgPath != "" {
+				continue // Skip unexported fields.
+			}
+			if fieldName, ok := autoFlexFieldName(field); ok && matcher.matches(name, collection, fieldName, field.Type) {
+				return val.Field(i)
 			}
 		}
 	}
 
-	return fmt.Errorf("incompatible (%s): %s", tFrom, kTo)
+	return reflect.Value{}
+}
+
+// setString sets `valTo`, a string (or string-typed enum) or pointer to string, to `s`.
+// Returns false if `valTo` is not a string.
+func setString(valTo reflect.Value, s string) bool {
+	switch valTo.Kind() {
+	case reflect.String:
+		valTo.SetString(s)
+		return true
+	case reflect.Ptr:
+		if tElem := valTo.Type().Elem(); tElem.Kind() == reflect.String {
+			val := reflect.New(tElem)
+			val.Elem().SetString(s)
+			valTo.Set(val)
+			return true
+		}
+	}
+
+	return false
 }
@@ -3,11 +3,15 @@ package flex
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
 type ATestExpand struct{}
@@ -92,11 +96,139 @@ type UTestExpand struct {
 	Names types.List
 }
 
+type TestEnum string
+
+const (
+	TestEnumScalar TestEnum = "Scalar"
+	TestEnumList   TestEnum = "List"
+)
+
+type VTestExpand struct {
+	Name TestEnum
+}
+
+type WTestExpand struct {
+	Names []TestEnum
+}
+
+type XTestExpand struct {
+	Attributes types.Map
+}
+
+type YTestExpand struct {
+	Attributes map[string]string
+}
+
+type ZTestExpand struct {
+	Attributes map[string]*string
+}
+
+type AATestExpand struct {
+	ARN       fwtypes.ARN
+	CIDRBlock fwtypes.CIDRBlock
+	Duration  fwtypes.Duration
+	Timestamp fwtypes.TimestampValue
+}
+
+type ABTestExpand struct {
+	ARN       *string
+	CIDRBlock string
+	Duration  *string
+	Timestamp *time.Time
+}
+
+type ACTestExpand struct {
+	Duration  time.Duration
+	Timestamp time.Time
+}
+
+type ADTestExpand struct {
+	Config types.List
+}
+
+type AETestExpand struct {
+	Config []AFTestExpand
+}
+
+type AFTestExpand struct {
+	InstanceType *string
+	Count        int32
+	Kind         TestEnum
+}
+
+type AGTestExpand struct {
+	Config []*AFTestExpand
+}
+
+type AHTestExpand struct {
+	Config *AFTestExpand
+}
+
+type AITestExpand struct {
+	Config types.Set
+}
+
+type AJTestExpand struct {
+	Attributes []string
+}
+
+type AKTestExpand struct {
+	Ids             types.List `autoflex:"InstanceIds"`
+	RoleArn         types.String
+	SecurityGroupId types.List
+	Policy          types.List
+	Ignored         types.String `autoflex:"-"`
+}
+
+type ALTestExpand struct {
+	InstanceIds      []string
+	RoleARN          *string
+	SecurityGroupIds []string
+	Policies         []string
+	Ignored          *string
+}
+
+type AMTestExpand struct {
+	Aliases types.Set
+}
+
+type ANTestExpand struct {
+	Aliases *ANTestExpandAliases
+}
+
+type ANTestExpandAliases struct {
+	Items    []string
+	Quantity *int32
+}
+
+type AOTestExpand struct {
+	Name types.String
+}
+
+type APTestExpand struct {
+	Names []string
+}
+
 func TestGenericExpand(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 	testString := "test"
+	testARN := "arn:aws:iam::123456789012:role/test"
+	testTimeStr := "2013-09-25T09:34:01Z"
+	testTime, _ := time.Parse(time.RFC3339, testTimeStr)
+	testObjectType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"instance_type": types.StringType,
+		"count":         types.Int64Type,
+		"kind":          types.StringType,
+	}}
+	testObject := func(instanceType string, count int64) attr.Value {
+		return types.ObjectValueMust(testObjectType.AttrTypes, map[string]attr.Value{
+			"instance_type": types.StringValue(instanceType),
+			"count":         types.Int64Value(count),
+			"kind":          types.StringValue(string(TestEnumList)),
+		})
+	}
 	testCases := []struct {
 		TestName   string
 		Source     any
@@ -264,6 +396,135 @@ func TestGenericExpand(t *testing.T) {
 			Target:     &TTestExpand{},
 			WantTarget: &TTestExpand{Names: aws.StringSlice([]string{"a"})},
 		},
+		{
+			TestName:   "single string Source and single enum Target",
+			Source:     &BTestExpand{Name: types.StringValue(string(TestEnumScalar))},
+			Target:     &VTestExpand{},
+			WantTarget: &VTestExpand{Name: TestEnumScalar},
+		},
+		{
+			TestName:   "single list Source and single enum slice Target",
+			Source:     &UTestExpand{Names: types.ListValueMust(types.StringType, []attr.Value{types.StringValue(string(TestEnumScalar)), types.StringValue(string(TestEnumList))})},
+			Target:     &WTestExpand{},
+			WantTarget: &WTestExpand{Names: []TestEnum{TestEnumScalar, TestEnumList}},
+		},
+		{
+			TestName:   "single map Source and single string map Target",
+			Source:     &XTestExpand{Attributes: types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")})},
+			Target:     &YTestExpand{},
+			WantTarget: &YTestExpand{Attributes: map[string]string{"k": "v"}},
+		},
+		{
+			TestName:   "single map Source and single *string map Target",
+			Source:     &XTestExpand{Attributes: types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")})},
+			Target:     &ZTestExpand{},
+			WantTarget: &ZTestExpand{Attributes: map[string]*string{"k": aws.String("v")}},
+		},
+		{
+			TestName: "single map Source and single string slice Target",
+			Source:   &XTestExpand{Attributes: types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")})},
+			Target:   &AJTestExpand{},
+			WantErr:  true,
+		},
+		{
+			TestName: "custom types Source and string Target",
+			Source: &AATestExpand{
+				ARN:       fwtypes.ARNValue(errs.Must(arn.Parse(testARN))),
+				CIDRBlock: fwtypes.CIDRBlockValue("10.0.0.0/16"),
+				Duration:  fwtypes.DurationValue(10 * time.Minute),
+				Timestamp: fwtypes.NewTimestampValue(testTime),
+			},
+			Target: &ABTestExpand{},
+			WantTarget: &ABTestExpand{
+				ARN:       aws.String(testARN),
+				CIDRBlock: "10.0.0.0/16",
+				Duration:  aws.String("10m0s"),
+				Timestamp: aws.Time(testTime),
+			},
+		},
+		{
+			TestName:   "custom types Source and time Target",
+			Source:     &AATestExpand{Duration: fwtypes.DurationValue(10 * time.Minute), Timestamp: fwtypes.NewTimestampValue(testTime)},
+			Target:     &ACTestExpand{},
+			WantTarget: &ACTestExpand{Duration: 10 * time.Minute, Timestamp: testTime},
+		},
+		{
+			TestName:   "null custom types Source and string Target",
+			Source:     &AATestExpand{ARN: fwtypes.ARNNull(), CIDRBlock: fwtypes.CIDRBlockNull(), Duration: fwtypes.DurationNull(), Timestamp: fwtypes.NewTimestampNull()},
+			Target:     &ABTestExpand{},
+			WantTarget: &ABTestExpand{},
+		},
+		{
+			TestName:   "nested list Source and struct slice Target",
+			Source:     &ADTestExpand{Config: types.ListValueMust(testObjectType, []attr.Value{testObject("t3.micro", 1), testObject("t3.large", 2)})},
+			Target:     &AETestExpand{},
+			WantTarget: &AETestExpand{Config: []AFTestExpand{{InstanceType: aws.String("t3.micro"), Count: 1, Kind: TestEnumList}, {InstanceType: aws.String("t3.large"), Count: 2, Kind: TestEnumList}}},
+		},
+		{
+			TestName:   "nested set Source and *struct slice Target",
+			Source:     &AITestExpand{Config: types.SetValueMust(testObjectType, []attr.Value{testObject("t3.micro", 1)})},
+			Target:     &AGTestExpand{},
+			WantTarget: &AGTestExpand{Config: []*AFTestExpand{{InstanceType: aws.String("t3.micro"), Count: 1, Kind: TestEnumList}}},
+		},
+		{
+			TestName:   "nested list Source and *struct Target",
+			Source:     &ADTestExpand{Config: types.ListValueMust(testObjectType, []attr.Value{testObject("t3.micro", 1)})},
+			Target:     &AHTestExpand{},
+			WantTarget: &AHTestExpand{Config: &AFTestExpand{InstanceType: aws.String("t3.micro"), Count: 1, Kind: TestEnumList}},
+		},
+		{
+			TestName:   "empty nested list Source and *struct Target",
+			Source:     &ADTestExpand{Config: types.ListValueMust(testObjectType, []attr.Value{})},
+			Target:     &AHTestExpand{},
+			WantTarget: &AHTestExpand{},
+		},
+		{
+			TestName: "too many elements nested list Source and *struct Target",
+			Source:   &ADTestExpand{Config: types.ListValueMust(testObjectType, []attr.Value{testObject("t3.micro", 1), testObject("t3.large", 2)})},
+			Target:   &AHTestExpand{},
+			WantErr:  true,
+		},
+		{
+			TestName: "nested list Source and string slice Target",
+			Source:   &UTestExpand{Names: types.ListValueMust(testObjectType, []attr.Value{testObject("t3.micro", 1)})},
+			Target:   &STestExpand{},
+			WantErr:  true,
+		},
+		{
+			TestName: "field name mapping Source and Target",
+			Source: &AKTestExpand{
+				Ids:             types.ListValueMust(types.StringType, []attr.Value{types.StringValue("i-1")}),
+				RoleArn:         types.StringValue(testARN),
+				SecurityGroupId: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("sg-1")}),
+				Policy:          types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testString)}),
+				Ignored:         types.StringValue(testString),
+			},
+			Target: &ALTestExpand{},
+			WantTarget: &ALTestExpand{
+				InstanceIds:      []string{"i-1"},
+				RoleARN:          aws.String(testARN),
+				SecurityGroupIds: []string{"sg-1"},
+				Policies:         []string{testString},
+			},
+		},
+		{
+			TestName:   "string Source and plural name string slice Target",
+			Source:     &AOTestExpand{Name: types.StringValue(testString)},
+			Target:     &APTestExpand{},
+			WantTarget: &APTestExpand{},
+		},
+		{
+			TestName:   "set Source and Items/Quantity Target",
+			Source:     &AMTestExpand{Aliases: types.SetValueMust(types.StringType, []attr.Value{types.StringValue(testString)})},
+			Target:     &ANTestExpand{},
+			WantTarget: &ANTestExpand{Aliases: &ANTestExpandAliases{Items: []string{testString}, Quantity: aws.Int32(1)}},
+		},
+		{
+			TestName:   "empty set Source and Items/Quantity Target",
+			Source:     &AMTestExpand{Aliases: types.SetValueMust(types.StringType, []attr.Value{})},
+			Target:     &ANTestExpand{},
+			WantTarget: &ANTestExpand{Aliases: &ANTestExpandAliases{Items: []string{}, Quantity: aws.Int32(0)}},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -4,10 +4,16 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
 // Flatten "flattens" an AWS SDK for Go v2 API data structure into
@@ -16,6 +22,9 @@ import (
 // The API data structure's fields are walked and exported fields that
 // have a corresponding field in the resource's data structure (and a
 // suitable target data type) are copied.
+// Flattening structs and slices of structs into nested Lists and Sets of Objects
+// requires the target's element type, so the resource's data structure should be
+// populated from the plan or state before flattening.
 func Flatten(ctx context.Context, apiObject, tfObject any) error {
 	if err := walkStructFields(ctx, apiObject, tfObject, flattenVisitor{}); err != nil {
 		return fmt.Errorf("Flatten[%T, %T]: %w", apiObject, tfObject, err)
@@ -32,133 +41,354 @@ func (v flattenVisitor) visit(ctx context.Context, fieldName string, valFrom, va
 		return fmt.Errorf("does not implement attr.Value: %s", valTo.Kind())
 	}
 
-	kFrom, tTo := valFrom.Kind(), vTo.Type(ctx)
+	val, err := flattenValue(ctx, valFrom, vTo.Type(ctx))
+
+	if err != nil {
+		return err
+	}
+
+	if typ := reflect.TypeOf(val); !typ.AssignableTo(valTo.Type()) {
+		return fmt.Errorf("incompatible (%s): %s", typ, valTo.Type())
+	}
+
+	valTo.Set(reflect.ValueOf(val))
+
+	return nil
+}
+
+// flattenValue returns the Plugin Framework value of type `tTo` corresponding to the AWS API value `valFrom`.
+// A nil pointer, slice or map is flattened to a null value.
+// Nested structs, slices and maps are flattened recursively.
+func flattenValue(ctx context.Context, valFrom reflect.Value, tTo attr.Type) (attr.Value, error) {
+	isNull := false
+	for valFrom.Kind() == reflect.Ptr {
+		if valFrom.IsNil() {
+			isNull = true
+			valFrom = reflect.Zero(valFrom.Type().Elem())
+		} else {
+			valFrom = valFrom.Elem()
+		}
+	}
+
+	kFrom := valFrom.Kind()
+
+	// Custom types.
+	switch {
+	case tTo.Equal(fwtypes.ARNType):
+		switch kFrom {
+		case reflect.String:
+			if isNull {
+				return fwtypes.ARNNull(), nil
+			}
+
+			v, err := arn.Parse(valFrom.String())
+
+			if err != nil {
+				return nil, err
+			}
+
+			return fwtypes.ARNValue(v), nil
+		}
+
+	case tTo.Equal(fwtypes.CIDRBlockType):
+		switch kFrom {
+		case reflect.String:
+			if isNull {
+				return fwtypes.CIDRBlockNull(), nil
+			}
+
+			return fwtypes.CIDRBlockValue(valFrom.String()), nil
+		}
+
+	case tTo.Equal(fwtypes.DurationType):
+		switch {
+		case valFrom.Type() == reflect.TypeOf(time.Duration(0)):
+			if isNull {
+				return fwtypes.DurationNull(), nil
+			}
+
+			return fwtypes.DurationValue(time.Duration(valFrom.Int())), nil
+
+		case kFrom == reflect.String:
+			if isNull {
+				return fwtypes.DurationNull(), nil
+			}
+
+			v, err := time.ParseDuration(valFrom.String())
+
+			if err != nil {
+				return nil, err
+			}
+
+			return fwtypes.DurationValue(v), nil
+		}
+
+	case tTo.Equal(fwtypes.TimestampType{}):
+		switch {
+		case valFrom.Type() == reflect.TypeOf(time.Time{}):
+			if isNull {
+				return fwtypes.NewTimestampNull(), nil
+			}
+
+			return fwtypes.NewTimestampValue(valFrom.Interface().(time.Time)), nil
+
+		case kFrom == reflect.String:
+			if isNull {
+				return fwtypes.NewTimestampNull(), nil
+			}
+
+			return fwtypes.NewTimestampValueString(valFrom.String())
+		}
+	}
+
 	switch kFrom {
 	case reflect.Bool:
-		vFrom := valFrom.Bool()
 		switch {
 		case tTo.Equal(types.BoolType):
-			valTo.Set(reflect.ValueOf(types.BoolValue(vFrom)))
-			return nil
+			if isNull {
+				return types.BoolNull(), nil
+			}
+
+			return types.BoolValue(valFrom.Bool()), nil
 		}
 
 	case reflect.Float32, reflect.Float64:
-		vFrom := valFrom.Float()
 		switch {
 		case tTo.Equal(types.Float64Type):
-			valTo.Set(reflect.ValueOf(types.Float64Value(vFrom)))
-			return nil
+			if isNull {
+				return types.Float64Null(), nil
+			}
+
+			return types.Float64Value(valFrom.Float()), nil
 		}
 
 	case reflect.Int32, reflect.Int64:
-		vFrom := valFrom.Int()
 		switch {
 		case tTo.Equal(types.Int64Type):
-			valTo.Set(reflect.ValueOf(types.Int64Value(vFrom)))
-			return nil
+			if isNull {
+				return types.Int64Null(), nil
+			}
+
+			return types.Int64Value(valFrom.Int()), nil
 		}
 
 	case reflect.String:
-		vFrom := valFrom.String()
+		// Also handles string-typed enums.
 		switch {
 		case tTo.Equal(types.StringType):
-			valTo.Set(reflect.ValueOf(types.StringValue(vFrom)))
-			return nil
-		}
-
-	case reflect.Ptr:
-		vFrom := valFrom.Elem()
-		switch valFrom.Type().Elem().Kind() {
-		case reflect.Bool:
-			switch {
-			case tTo.Equal(types.BoolType):
-				if vFrom.IsValid() {
-					valTo.Set(reflect.ValueOf(types.BoolValue(vFrom.Bool())))
-				} else {
-					valTo.Set(reflect.ValueOf(types.BoolNull()))
-				}
-				return nil
+			if isNull {
+				return types.StringNull(), nil
 			}
 
-		case reflect.Float32, reflect.Float64:
-			switch {
-			case tTo.Equal(types.Float64Type):
-				if vFrom.IsValid() {
-					valTo.Set(reflect.ValueOf(types.Float64Value(vFrom.Float())))
-				} else {
-					valTo.Set(reflect.ValueOf(types.Float64Null()))
-				}
-				return nil
+			return types.StringValue(valFrom.String()), nil
+		}
+
+		// Aggregate types.
+	case reflect.Slice:
+		switch tTo := tTo.(type) {
+		case types.ListType:
+			elemType, err := elementType(tTo.ElemType, valFrom.Type().Elem())
+
+			if err != nil {
+				return nil, err
 			}
 
-		case reflect.Int32, reflect.Int64:
-			switch {
-			case tTo.Equal(types.Int64Type):
-				if vFrom.IsValid() {
-					valTo.Set(reflect.ValueOf(types.Int64Value(vFrom.Int())))
-				} else {
-					valTo.Set(reflect.ValueOf(types.Int64Null()))
-				}
-				return nil
+			if isNull || valFrom.Len() == 0 {
+				return types.ListNull(elemType), nil
 			}
 
-		case reflect.String:
-			switch {
-			case tTo.Equal(types.StringType):
-				if vFrom.IsValid() {
-					valTo.Set(reflect.ValueOf(types.StringValue(vFrom.String())))
-				} else {
-					valTo.Set(reflect.ValueOf(types.StringNull()))
+			elems, err := flattenElements(ctx, valFrom, elemType)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return diagnosticsValue(types.ListValue(elemType, elems))
+
+		case types.SetType:
+			elemType, err := elementType(tTo.ElemType, valFrom.Type().Elem())
+
+			if err != nil {
+				return nil, err
+			}
+
+			if isNull || valFrom.Len() == 0 {
+				return types.SetNull(elemType), nil
+			}
+
+			elems, err := flattenElements(ctx, valFrom, elemType)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return diagnosticsValue(types.SetValue(elemType, elems))
+		}
+
+	case reflect.Map:
+		switch tTo := tTo.(type) {
+		case types.MapType:
+			if valFrom.Type().Key().Kind() != reflect.String {
+				break
+			}
+
+			elemType, err := elementType(tTo.ElemType, valFrom.Type().Elem())
+
+			if err != nil {
+				return nil, err
+			}
+
+			if isNull || valFrom.Len() == 0 {
+				return types.MapNull(elemType), nil
+			}
+
+			elems := make(map[string]attr.Value, valFrom.Len())
+			iter := valFrom.MapRange()
+			for iter.Next() {
+				k := iter.Key().String()
+				v, err := flattenValue(ctx, iter.Value(), elemType)
+
+				if err != nil {
+					return nil, fmt.Errorf("key (%s): %w", k, err)
 				}
-				return nil
+
+				elems[k] = v
 			}
+
+			return diagnosticsValue(types.MapValue(elemType, elems))
 		}
 
-	case reflect.Slice:
-		vFrom := valFrom.Interface()
-		switch tSliceElem := valFrom.Type().Elem(); tSliceElem.Kind() {
-		case reflect.String:
-			switch {
-			case tTo.TerraformType(ctx).Is(tftypes.List{}):
-				if vFrom != nil {
-					valTo.Set(reflect.ValueOf(FlattenFrameworkStringValueList(ctx, vFrom.([]string))))
-				} else {
-					valTo.Set(reflect.ValueOf(types.ListNull(types.StringType)))
+	case reflect.Struct:
+		// An {Items, Quantity} wrapper struct is flattened to its items.
+		if isItemsQuantityWrapper(valFrom.Type()) {
+			switch tTo.(type) {
+			case types.ListType, types.SetType:
+				return flattenValue(ctx, valFrom.FieldByName("Items"), tTo)
+			}
+		}
+
+		switch tTo := tTo.(type) {
+		case types.ObjectType:
+			if isNull {
+				return types.ObjectNull(tTo.AttrTypes), nil
+			}
+
+			return flattenObject(ctx, valFrom, tTo)
+
+		// A struct is flattened to a nested block with a single element.
+		case types.ListType:
+			if elemType, ok := tTo.ElemType.(types.ObjectType); ok {
+				if isNull {
+					return types.ListNull(elemType), nil
 				}
-				return nil
 
-			case tTo.TerraformType(ctx).Is(tftypes.Set{}):
-				if vFrom != nil {
-					valTo.Set(reflect.ValueOf(FlattenFrameworkStringValueSet(ctx, vFrom.([]string))))
-				} else {
-					valTo.Set(reflect.ValueOf(types.SetNull(types.StringType)))
+				elem, err := flattenObject(ctx, valFrom, elemType)
+
+				if err != nil {
+					return nil, err
 				}
-				return nil
-			}
-
-		case reflect.Ptr:
-			switch tSliceElem.Elem().Kind() {
-			case reflect.String:
-				switch {
-				case tTo.TerraformType(ctx).Is(tftypes.List{}):
-					if vFrom != nil {
-						valTo.Set(reflect.ValueOf(FlattenFrameworkStringList(ctx, vFrom.([]*string))))
-					} else {
-						valTo.Set(reflect.ValueOf(types.ListNull(types.StringType)))
-					}
-					return nil
-
-				case tTo.TerraformType(ctx).Is(tftypes.Set{}):
-					if vFrom != nil {
-						valTo.Set(reflect.ValueOf(FlattenFrameworkStringSet(ctx, vFrom.([]*string))))
-					} else {
-						valTo.Set(reflect.ValueOf(types.SetNull(types.StringType)))
-					}
-					return nil
+
+				return diagnosticsValue(types.ListValue(elemType, []attr.Value{elem}))
+			}
+
+		case types.SetType:
+			if elemType, ok := tTo.ElemType.(types.ObjectType); ok {
+				if isNull {
+					return types.SetNull(elemType), nil
+				}
+
+				elem, err := flattenObject(ctx, valFrom, elemType)
+
+				if err != nil {
+					return nil, err
 				}
+
+				return diagnosticsValue(types.SetValue(elemType, []attr.Value{elem}))
 			}
 		}
 	}
 
-	return fmt.Errorf("incompatible (%s): %s", kFrom, tTo)
+	return nil, fmt.Errorf("incompatible (%s): %s", kFrom, tTo)
+}
+
+// flattenElements returns the Plugin Framework values of type `elemType` corresponding to the elements of slice `valFrom`.
+func flattenElements(ctx context.Context, valFrom reflect.Value, elemType attr.Type) ([]attr.Value, error) {
+	elems := make([]attr.Value, valFrom.Len())
+
+	for i := 0; i < valFrom.Len(); i++ {
+		v, err := flattenValue(ctx, valFrom.Index(i), elemType)
+
+		if err != nil {
+			return nil, fmt.Errorf("element (%d): %w", i, err)
+		}
+
+		elems[i] = v
+	}
+
+	return elems, nil
+}
+
+// flattenObject returns the Plugin Framework Object of type `tTo` corresponding to the AWS API struct `valFrom`.
+// Attributes with no corresponding field are set to null.
+func flattenObject(ctx context.Context, valFrom reflect.Value, tTo types.ObjectType) (attr.Value, error) {
+	if tTo.AttrTypes == nil {
+		return nil, fmt.Errorf("incompatible (%s): %s", valFrom.Kind(), tTo)
+	}
+
+	attrs := make(map[string]attr.Value, len(tTo.AttrTypes))
+
+	for name, attrType := range tTo.AttrTypes {
+		var v attr.Value
+		var err error
+
+		if fromFieldVal := fieldByAttributeName(valFrom, name, isCollectionAttrType(attrType)); fromFieldVal.IsValid() {
+			v, err = flattenValue(ctx, fromFieldVal, attrType)
+		} else {
+			v, err = attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("attribute (%s): %w", name, err)
+		}
+
+		attrs[name] = v
+	}
+
+	return diagnosticsValue(types.ObjectValue(tTo.AttrTypes, attrs))
+}
+
+// isCollectionAttrType reports whether `attrType` is a Plugin Framework List or Set type.
+func isCollectionAttrType(attrType attr.Type) bool {
+	switch attrType.(type) {
+	case basetypes.ListTypable, basetypes.SetTypable:
+		return true
+	default:
+		return false
+	}
+}
+
+// elementType returns the element type of a List, Set or Map.
+// The element type of a zero-valued List, Set or Map is unknown and defaults to String for string source elements.
+func elementType(elemType attr.Type, typFrom reflect.Type) (attr.Type, error) {
+	if elemType != nil {
+		return elemType, nil
+	}
+
+	for typFrom.Kind() == reflect.Ptr {
+		typFrom = typFrom.Elem()
+	}
+
+	if typFrom.Kind() == reflect.String {
+		return types.StringType, nil
+	}
+
+	return nil, fmt.Errorf("unknown element type for %s", typFrom)
+}
+
+func diagnosticsValue[T attr.Value](v T, diags diag.Diagnostics) (attr.Value, error) {
+	if err := fwdiag.DiagnosticsError(diags); err != nil {
+		return nil, err
+	}
+
+	return v, nil
 }
@@ -3,11 +3,15 @@ package flex
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
 type ATestFlatten struct{}
@@ -92,11 +96,123 @@ type UTestFlatten struct {
 	Names types.List
 }
 
+type VTestFlatten struct {
+	Name TestEnum
+}
+
+type WTestFlatten struct {
+	Names []TestEnum
+}
+
+type XTestFlatten struct {
+	Attributes map[string]string
+}
+
+type YTestFlatten struct {
+	Attributes map[string]*string
+}
+
+type ZTestFlatten struct {
+	Attributes types.Map
+}
+
+type AATestFlatten struct {
+	ARN       *string
+	CIDRBlock string
+	Duration  *string
+	Timestamp *time.Time
+}
+
+type ABTestFlatten struct {
+	ARN       fwtypes.ARN
+	CIDRBlock fwtypes.CIDRBlock
+	Duration  fwtypes.Duration
+	Timestamp fwtypes.TimestampValue
+}
+
+type ACTestFlatten struct {
+	Duration  time.Duration
+	Timestamp time.Time
+}
+
+type ADTestFlatten struct {
+	Config []AFTestFlatten
+}
+
+type AETestFlatten struct {
+	Config types.List
+}
+
+type AFTestFlatten struct {
+	InstanceType *string
+	Count        int32
+	Kind         TestEnum
+	Ignored      bool
+}
+
+type AGTestFlatten struct {
+	Config []*AFTestFlatten
+}
+
+type AHTestFlatten struct {
+	Config *AFTestFlatten
+}
+
+type AITestFlatten struct {
+	Config types.Set
+}
+
+type AJTestFlatten struct {
+	InstanceIds      []string
+	RoleARN          *string
+	SecurityGroupIds []string
+	Policies         []string
+	Ignored          *string
+}
+
+type AKTestFlatten struct {
+	Ids             types.List `autoflex:"InstanceIds"`
+	RoleArn         types.String
+	SecurityGroupId types.List
+	Policy          types.List
+	Ignored         types.String `autoflex:"-"`
+}
+
+type ALTestFlatten struct {
+	Aliases *ALTestFlattenAliases
+}
+
+type ALTestFlattenAliases struct {
+	Items    []string
+	Quantity *int32
+}
+
+type AMTestFlatten struct {
+	Aliases types.Set
+}
+
 func TestGenericFlatten(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 	testString := "test"
+	testARN := "arn:aws:iam::123456789012:role/test"
+	testTimeStr := "2013-09-25T09:34:01Z"
+	testTime, _ := time.Parse(time.RFC3339, testTimeStr)
+	testObjectType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"instance_type": types.StringType,
+		"count":         types.Int64Type,
+		"kind":          types.StringType,
+		"missing":       types.BoolType,
+	}}
+	testObject := func(instanceType string, count int64) attr.Value {
+		return types.ObjectValueMust(testObjectType.AttrTypes, map[string]attr.Value{
+			"instance_type": types.StringValue(instanceType),
+			"count":         types.Int64Value(count),
+			"kind":          types.StringValue(string(TestEnumList)),
+			"missing":       types.BoolNull(),
+		})
+	}
 	testCases := []struct {
 		TestName   string
 		Source     any
@@ -282,6 +398,129 @@ func TestGenericFlatten(t *testing.T) {
 			Target:     &UTestFlatten{},
 			WantTarget: &UTestFlatten{Names: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")})},
 		},
+		{
+			TestName:   "single enum Source and single string Target",
+			Source:     &VTestFlatten{Name: TestEnumScalar},
+			Target:     &DTestFlatten{},
+			WantTarget: &DTestFlatten{Name: types.StringValue(string(TestEnumScalar))},
+		},
+		{
+			TestName:   "single enum slice Source and single list Target",
+			Source:     &WTestFlatten{Names: []TestEnum{TestEnumScalar, TestEnumList}},
+			Target:     &UTestFlatten{},
+			WantTarget: &UTestFlatten{Names: types.ListValueMust(types.StringType, []attr.Value{types.StringValue(string(TestEnumScalar)), types.StringValue(string(TestEnumList))})},
+		},
+		{
+			TestName:   "single string map Source and single map Target",
+			Source:     &XTestFlatten{Attributes: map[string]string{"k": "v"}},
+			Target:     &ZTestFlatten{},
+			WantTarget: &ZTestFlatten{Attributes: types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")})},
+		},
+		{
+			TestName:   "single *string map Source and single map Target",
+			Source:     &YTestFlatten{Attributes: map[string]*string{"k": aws.String("v")}},
+			Target:     &ZTestFlatten{},
+			WantTarget: &ZTestFlatten{Attributes: types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")})},
+		},
+		{
+			TestName:   "single nil map Source and single map Target",
+			Source:     &XTestFlatten{},
+			Target:     &ZTestFlatten{},
+			WantTarget: &ZTestFlatten{Attributes: types.MapNull(types.StringType)},
+		},
+		{
+			TestName: "string Source and custom types Target",
+			Source: &AATestFlatten{
+				ARN:       aws.String(testARN),
+				CIDRBlock: "10.0.0.0/16",
+				Duration:  aws.String("10m0s"),
+				Timestamp: aws.Time(testTime),
+			},
+			Target: &ABTestFlatten{},
+			WantTarget: &ABTestFlatten{
+				ARN:       fwtypes.ARNValue(errs.Must(arn.Parse(testARN))),
+				CIDRBlock: fwtypes.CIDRBlockValue("10.0.0.0/16"),
+				Duration:  fwtypes.DurationValue(10 * time.Minute),
+				Timestamp: fwtypes.NewTimestampValue(testTime),
+			},
+		},
+		{
+			TestName:   "nil Source and custom types Target",
+			Source:     &AATestFlatten{},
+			Target:     &ABTestFlatten{},
+			WantTarget: &ABTestFlatten{ARN: fwtypes.ARNNull(), CIDRBlock: fwtypes.CIDRBlockValue(""), Duration: fwtypes.DurationNull(), Timestamp: fwtypes.NewTimestampNull()},
+		},
+		{
+			TestName:   "time Source and custom types Target",
+			Source:     &ACTestFlatten{Duration: 10 * time.Minute, Timestamp: testTime},
+			Target:     &ABTestFlatten{},
+			WantTarget: &ABTestFlatten{Duration: fwtypes.DurationValue(10 * time.Minute), Timestamp: fwtypes.NewTimestampValue(testTime)},
+		},
+		{
+			TestName: "invalid ARN Source and ARN Target",
+			Source:   &AATestFlatten{ARN: aws.String(testString)},
+			Target:   &ABTestFlatten{},
+			WantErr:  true,
+		},
+		{
+			TestName:   "struct slice Source and nested list Target",
+			Source:     &ADTestFlatten{Config: []AFTestFlatten{{InstanceType: aws.String("t3.micro"), Count: 1, Kind: TestEnumList, Ignored: true}, {InstanceType: aws.String("t3.large"), Count: 2, Kind: TestEnumList}}},
+			Target:     &AETestFlatten{Config: types.ListNull(testObjectType)},
+			WantTarget: &AETestFlatten{Config: types.ListValueMust(testObjectType, []attr.Value{testObject("t3.micro", 1), testObject("t3.large", 2)})},
+		},
+		{
+			TestName:   "*struct slice Source and nested set Target",
+			Source:     &AGTestFlatten{Config: []*AFTestFlatten{{InstanceType: aws.String("t3.micro"), Count: 1, Kind: TestEnumList}}},
+			Target:     &AITestFlatten{Config: types.SetNull(testObjectType)},
+			WantTarget: &AITestFlatten{Config: types.SetValueMust(testObjectType, []attr.Value{testObject("t3.micro", 1)})},
+		},
+		{
+			TestName:   "*struct Source and nested list Target",
+			Source:     &AHTestFlatten{Config: &AFTestFlatten{InstanceType: aws.String("t3.micro"), Count: 1, Kind: TestEnumList}},
+			Target:     &AETestFlatten{Config: types.ListNull(testObjectType)},
+			WantTarget: &AETestFlatten{Config: types.ListValueMust(testObjectType, []attr.Value{testObject("t3.micro", 1)})},
+		},
+		{
+			TestName:   "nil *struct Source and nested list Target",
+			Source:     &AHTestFlatten{},
+			Target:     &AETestFlatten{Config: types.ListNull(testObjectType)},
+			WantTarget: &AETestFlatten{Config: types.ListNull(testObjectType)},
+		},
+		{
+			TestName: "struct slice Source and zero-valued nested list Target",
+			Source:   &ADTestFlatten{Config: []AFTestFlatten{{InstanceType: aws.String("t3.micro")}}},
+			Target:   &AETestFlatten{},
+			WantErr:  true,
+		},
+		{
+			TestName: "field name mapping Source and Target",
+			Source: &AJTestFlatten{
+				InstanceIds:      []string{"i-1"},
+				RoleARN:          aws.String(testARN),
+				SecurityGroupIds: []string{"sg-1"},
+				Policies:         []string{testString},
+				Ignored:          aws.String(testString),
+			},
+			Target: &AKTestFlatten{},
+			WantTarget: &AKTestFlatten{
+				Ids:             types.ListValueMust(types.StringType, []attr.Value{types.StringValue("i-1")}),
+				RoleArn:         types.StringValue(testARN),
+				SecurityGroupId: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("sg-1")}),
+				Policy:          types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testString)}),
+			},
+		},
+		{
+			TestName:   "Items/Quantity Source and set Target",
+			Source:     &ALTestFlatten{Aliases: &ALTestFlattenAliases{Items: []string{testString}, Quantity: aws.Int32(1)}},
+			Target:     &AMTestFlatten{},
+			WantTarget: &AMTestFlatten{Aliases: types.SetValueMust(types.StringType, []attr.Value{types.StringValue(testString)})},
+		},
+		{
+			TestName:   "nil Items/Quantity Source and set Target",
+			Source:     &ALTestFlatten{},
+			Target:     &AMTestFlatten{},
+			WantTarget: &AMTestFlatten{Aliases: types.SetNull(types.StringType)},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,78 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/report"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+var (
+	jsonFile  = flag.String("json", "", "JSON report output file")
+	junitFile = flag.String("junit", "", "JUnit XML report output file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] <report-file>\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+
+	if flag.NArg() != 1 || (*jsonFile == "" && *junitFile == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	filename := flag.Arg(0)
+	tests, err := report.Read(filename)
+
+	if err != nil {
+		g.Fatalf("%s", err)
+	}
+
+	summary := report.Summarize(tests)
+
+	if *jsonFile != "" {
+		g.Infof("Generating %s", *jsonFile)
+
+		if err := writeFile(*jsonFile, summary.WriteJSON); err != nil {
+			g.Fatalf("generating file (%s): %s", *jsonFile, err)
+		}
+	}
+
+	if *junitFile != "" {
+		g.Infof("Generating %s", *junitFile)
+
+		if err := writeFile(*junitFile, summary.WriteJUnit); err != nil {
+			g.Fatalf("generating file (%s): %s", *junitFile, err)
+		}
+	}
+}
+
+func writeFile(filename string, write func(io.Writer) error) error {
+	f, err := os.Create(filename)
+
+	if err != nil {
+		return err
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -144,6 +145,12 @@ func main() {
 			log.Fatalf("in names_data.csv, for service %s, DocPrefix cannot be blank unless Exclude is non-blank and AllowedSubcategory is blank", l[names.ColHumanFriendly])
 		}
 
+		if v := l[names.ColRateLimit]; v != "" {
+			if f, err := strconv.ParseFloat(v, 64); err != nil || f <= 0 {
+				log.Fatalf("in names_data.csv, for service %s, RateLimit must be blank or a positive number; found %s", l[names.ColHumanFriendly], v)
+			}
+		}
+
 		checkAllLowercase(l[names.ColHumanFriendly], "AWSCLIV2Command", l[names.ColAWSCLIV2Command])
 		checkAllLowercase(l[names.ColHumanFriendly], "AWSCLIV2CommandNoDashes", l[names.ColAWSCLIV2CommandNoDashes])
 		checkAllLowercase(l[names.ColHumanFriendly], "GoV1Package", l[names.ColGoV1Package])
@@ -0,0 +1,769 @@
+// Package frameworkmigrate converts Terraform Plugin SDK resources to Terraform Plugin Framework resources.
+//
+// The generated resource has a schema that is equivalent to, and a model that is state compatible with,
+// the SDK resource's. CRUD handlers, validators that cannot be introspected and other SDK behaviors
+// must be migrated by hand and are marked with TODO comments.
+package frameworkmigrate
+
+import (
+	_ "embed"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//go:embed resource.tmpl
+var ResourceTemplate string
+
+//go:embed resource_test.tmpl
+var ResourceTestTemplate string
+
+// Options describe the resource being migrated.
+type Options struct {
+	PackageName             string // Service package name, e.g. "appconfig".
+	ProviderNameUpper       string // Service name as used in test names, e.g. "AppConfig".
+	ResourceName            string // Go resource name, e.g. "Application".
+	HumanName               string // Human friendly resource name, e.g. "Application".
+	TypeName                string // Terraform resource type name, e.g. "aws_appconfig_application".
+	TagsIdentifierAttribute string // Identifier attribute for transparent tagging, if any.
+	SDKProviderVersion      string // Last provider version in which the resource is implemented using the SDK, e.g. "5.5.0".
+	ErrorCheckImport        string // Import spec for ErrorCheckService, e.g. `"github.com/aws/aws-sdk-go/service/appconfig"`.
+	ErrorCheckService       string // Service identifier passed to acctest.ErrorCheck in tests, e.g. "appconfig.EndpointsID".
+}
+
+// TemplateData is the data used to generate a migrated resource and its tests.
+type TemplateData struct {
+	Options
+
+	Attributes        []*Attribute
+	Objects           []*Object // All nested objects, in definition order.
+	SchemaVersion     int
+	StateUpgraders    bool
+	Importer          string // Name of the SDK resource's import function, if any.
+	ImportPassthrough bool
+	CustomizeDiff     bool
+	HasTags           bool
+	Timeouts          *Timeouts
+
+	imports map[string]bool
+}
+
+// Attribute is a Plugin Framework attribute or block.
+type Attribute struct {
+	GoName             string
+	TFName             string
+	SchemaKey          string // Key in the schema's Attributes or Blocks map.
+	Schema             string // Predefined schema, e.g. for the `id` attribute.
+	SchemaType         string // e.g. "schema.StringAttribute".
+	ModelType          string // e.g. "types.String".
+	AttrType           string // e.g. "types.StringType".
+	ElementType        string // Collection element type, e.g. "types.StringType".
+	Required           bool
+	Optional           bool
+	Computed           bool
+	Sensitive          bool
+	Block              bool
+	Default            string
+	Description        string
+	DeprecationMessage string
+	PlanModifierType   string // e.g. "planmodifier.String".
+	PlanModifiers      []string
+	ValidatorType      string // e.g. "validator.String".
+	Validators         []string
+	TODOs              []string // Validators that cannot be migrated automatically.
+	Comments           []string // Other SDK behaviors that must be migrated by hand.
+	Nested             *Object
+}
+
+// Object is a nested object, corresponding to an SDK `*schema.Resource` element.
+type Object struct {
+	Name       string // Go name prefix of the object's model struct and attribute types, e.g. "monitor".
+	Attributes []*Attribute
+}
+
+// Timeouts are default operation timeouts as Go duration expressions, e.g. "30 * time.Minute".
+type Timeouts struct {
+	Create, Read, Update, Delete string
+}
+
+// Blocks returns the object's blocks.
+func (o *Object) Blocks() []*Attribute {
+	return blocks(o.Attributes)
+}
+
+// NonBlocks returns the object's attributes.
+func (o *Object) NonBlocks() []*Attribute {
+	return nonBlocks(o.Attributes)
+}
+
+// Blocks returns the resource's top-level blocks.
+func (d *TemplateData) Blocks() []*Attribute {
+	return blocks(d.Attributes)
+}
+
+// NonBlocks returns the resource's top-level attributes.
+func (d *TemplateData) NonBlocks() []*Attribute {
+	return nonBlocks(d.Attributes)
+}
+
+// Imports returns the generated resource's import specs, grouped into standard library and other imports.
+func (d *TemplateData) Imports() [][]string {
+	var std, other []string
+
+	for _, pkg := range sortedKeys(d.imports) {
+		path, ok := importPaths[pkg]
+		if !ok {
+			continue
+		}
+
+		spec := strconv.Quote(path)
+		if path[strings.LastIndex(path, "/")+1:] != pkg {
+			spec = pkg + " " + spec
+		}
+
+		if strings.Contains(path, ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+
+	sort.Slice(other, func(i, j int) bool {
+		return importPath(other[i]) < importPath(other[j])
+	})
+	sort.Slice(std, func(i, j int) bool {
+		return importPath(std[i]) < importPath(std[j])
+	})
+
+	return [][]string{std, other}
+}
+
+func blocks(attributes []*Attribute) []*Attribute {
+	var v []*Attribute
+
+	for _, a := range attributes {
+		if a.Block {
+			v = append(v, a)
+		}
+	}
+
+	return v
+}
+
+func nonBlocks(attributes []*Attribute) []*Attribute {
+	var v []*Attribute
+
+	for _, a := range attributes {
+		if !a.Block {
+			v = append(v, a)
+		}
+	}
+
+	return v
+}
+
+// importPaths maps package names used in generated code to import paths.
+var importPaths = map[string]string{
+	"attr":                "github.com/hashicorp/terraform-plugin-framework/attr",
+	"boolplanmodifier":    "github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier",
+	"boolvalidator":       "github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator",
+	"booldefault":         "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault",
+	"context":             "context",
+	"float64default":      "github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default",
+	"float64planmodifier": "github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier",
+	"float64validator":    "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+	"framework":           "github.com/hashicorp/terraform-provider-aws/internal/framework",
+	"int64default":        "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default",
+	"int64planmodifier":   "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier",
+	"int64validator":      "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+	"listplanmodifier":    "github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier",
+	"listvalidator":       "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+	"mapplanmodifier":     "github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier",
+	"mapvalidator":        "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator",
+	"names":               "github.com/hashicorp/terraform-provider-aws/names",
+	"path":                "github.com/hashicorp/terraform-plugin-framework/path",
+	"planmodifier":        "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier",
+	"resource":            "github.com/hashicorp/terraform-plugin-framework/resource",
+	"schema":              "github.com/hashicorp/terraform-plugin-framework/resource/schema",
+	"setplanmodifier":     "github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier",
+	"setvalidator":        "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator",
+	"stringdefault":       "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault",
+	"stringplanmodifier":  "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+	"stringvalidator":     "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+	"tftags":              "github.com/hashicorp/terraform-provider-aws/internal/tags",
+	"time":                "time",
+	"timeouts":            "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts",
+	"types":               "github.com/hashicorp/terraform-plugin-framework/types",
+	"validator":           "github.com/hashicorp/terraform-plugin-framework/schema/validator",
+}
+
+// importPath returns the quoted import path of an import spec, ignoring any alias.
+func importPath(spec string) string {
+	if _, path, ok := strings.Cut(spec, " "); ok {
+		return path
+	}
+
+	return spec
+}
+
+// DefaultResourceName returns the Go name of resource `typeName` in service package `packageName`,
+// e.g. "aws_appconfig_application" becomes "Environment".
+func DefaultResourceName(typeName, packageName string) string {
+	return goName(strings.TrimPrefix(strings.TrimPrefix(typeName, "aws_"), packageName+"_"))
+}
+
+// DefaultHumanName returns the human friendly name of resource `typeName` in service package `packageName`,
+// e.g. "aws_vpclattice_service_network" becomes "Service Network".
+func DefaultHumanName(typeName, packageName string) string {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimPrefix(typeName, "aws_"), packageName+"_"), "_")
+
+	for i, part := range parts {
+		parts[i] = goName(part)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// NewTemplateData converts the SDK resource `r` to template data for its Plugin Framework equivalent.
+func NewTemplateData(r *schema.Resource, opts Options) (*TemplateData, error) {
+	s := r.SchemaMap()
+
+	if len(s) == 0 {
+		return nil, fmt.Errorf("resource (%s) has no schema", opts.TypeName)
+	}
+
+	d := &TemplateData{
+		Options:        opts,
+		SchemaVersion:  r.SchemaVersion,
+		StateUpgraders: len(r.StateUpgraders) > 0,
+		CustomizeDiff:  r.CustomizeDiff != nil,
+		imports:        make(map[string]bool),
+	}
+
+	d.use("context", "framework", "resource", "schema", "types")
+
+	if v := r.Importer; v != nil {
+		switch {
+		case v.StateContext != nil:
+			d.Importer = funcName(v.StateContext)
+		case v.State != nil: //nolint:staticcheck // Deprecated fields must also be migrated.
+			d.Importer = funcName(v.State) //nolint:staticcheck // Deprecated fields must also be migrated.
+		}
+
+		d.ImportPassthrough = d.Importer == funcName(schema.ImportStatePassthroughContext)
+
+		if d.Importer != "" && !d.ImportPassthrough {
+			d.use("names", "path")
+		}
+	}
+
+	_, hasTags := s[attrTags]
+	_, hasTagsAll := s[attrTagsAll]
+	d.HasTags = hasTags && hasTagsAll
+
+	objectNames := make(map[string]bool)
+
+	for _, k := range sortedKeys(s) {
+		if d.HasTags && (k == attrTags || k == attrTagsAll) {
+			continue
+		}
+
+		a, err := d.newAttribute(k, k, s[k], "", true, objectNames)
+		if err != nil {
+			return nil, err
+		}
+
+		d.Attributes = append(d.Attributes, a)
+	}
+
+	if _, ok := s[attrID]; !ok {
+		d.Attributes = append(d.Attributes, &Attribute{
+			GoName:    "ID",
+			TFName:    attrID,
+			SchemaKey: "names.AttrID",
+			Schema:    "framework.IDAttribute()",
+			ModelType: "types.String",
+		})
+		d.use("names")
+	}
+
+	if d.HasTags {
+		d.Attributes = append(d.Attributes,
+			&Attribute{
+				GoName:    "Tags",
+				TFName:    attrTags,
+				SchemaKey: "names.AttrTags",
+				Schema:    "tftags.TagsAttribute()",
+				ModelType: "types.Map",
+			},
+			&Attribute{
+				GoName:    "TagsAll",
+				TFName:    attrTagsAll,
+				SchemaKey: "names.AttrTagsAll",
+				Schema:    "tftags.TagsAttributeComputedOnly()",
+				ModelType: "types.Map",
+			},
+		)
+		d.use("names", "tftags")
+	}
+
+	sort.SliceStable(d.Attributes, func(i, j int) bool {
+		return d.Attributes[i].TFName < d.Attributes[j].TFName
+	})
+
+	if v := r.Timeouts; v != nil {
+		d.Timeouts = &Timeouts{
+			Create: durationExpr(v.Create, v.Default),
+			Read:   durationExpr(v.Read, v.Default),
+			Update: durationExpr(v.Update, v.Default),
+			Delete: durationExpr(v.Delete, v.Default),
+		}
+		d.use("time", "timeouts")
+	}
+
+	if len(d.Objects) > 0 {
+		d.use("attr")
+	}
+
+	return d, nil
+}
+
+const (
+	attrID      = "id"
+	attrTags    = "tags"
+	attrTagsAll = "tags_all"
+)
+
+// newAttribute converts the SDK schema `s` of attribute `tfName` to a Plugin Framework attribute or block.
+// `sdkPath` is the attribute's SDK path, e.g. "monitor.0.alarm_arn", and `parent` the Go name prefix of any enclosing object.
+// Attributes of objects nested in attributes have no schema of their own, only a model and attribute types.
+func (d *TemplateData) newAttribute(tfName, sdkPath string, s *schema.Schema, parent string, inSchema bool, objectNames map[string]bool) (*Attribute, error) {
+	a := &Attribute{
+		GoName:             goName(tfName),
+		TFName:             tfName,
+		SchemaKey:          strconv.Quote(tfName),
+		Required:           s.Required,
+		Optional:           s.Optional,
+		Computed:           s.Computed,
+		Sensitive:          s.Sensitive,
+		Description:        s.Description,
+		DeprecationMessage: s.Deprecated,
+	}
+
+	var (
+		kind           string // Framework type name, e.g. "String" or "List".
+		validatorsPkg  string
+		collectionElem *schema.Schema
+	)
+
+	switch s.Type {
+	case schema.TypeBool:
+		kind = "Bool"
+	case schema.TypeInt:
+		kind = "Int64"
+	case schema.TypeFloat:
+		kind = "Float64"
+	case schema.TypeString:
+		kind = "String"
+	case schema.TypeList:
+		kind = "List"
+	case schema.TypeSet:
+		kind = "Set"
+	case schema.TypeMap:
+		kind = "Map"
+	default:
+		return nil, fmt.Errorf("attribute (%s): unsupported type %s", sdkPath, s.Type)
+	}
+
+	lowerKind := strings.ToLower(kind)
+	validatorsPkg = lowerKind + "validator"
+	a.PlanModifierType = "planmodifier." + kind
+	a.ValidatorType = "validator." + kind
+
+	switch kind {
+	case "Bool", "Int64", "Float64", "String":
+		a.SchemaType = "schema." + kind + "Attribute"
+		a.ModelType = "types." + kind
+		a.AttrType = "types." + kind + "Type"
+
+		if s.Default != nil && inSchema {
+			v, err := defaultExpr(kind, s.Default)
+			if err != nil {
+				return nil, fmt.Errorf("attribute (%s): %w", sdkPath, err)
+			}
+
+			a.Default = v
+			// Attributes with default values must be computed.
+			a.Computed = true
+			d.use(lowerKind + "default")
+		}
+
+	case "List", "Set", "Map":
+		a.ModelType = "types." + kind
+
+		switch elem := s.Elem.(type) {
+		case *schema.Resource:
+			if kind == "Map" {
+				return nil, fmt.Errorf("attribute (%s): unsupported map of objects", sdkPath)
+			}
+
+			// Computed-only nested objects are attributes in the Plugin Framework, as are objects configured using attribute syntax.
+			block := inSchema && !(s.Computed && !s.Optional || s.ConfigMode == schema.SchemaConfigModeAttr)
+
+			name := objectName(tfName, parent, objectNames)
+			o := &Object{Name: name}
+			d.Objects = append(d.Objects, o)
+
+			for _, k := range sortedKeys(elem.SchemaMap()) {
+				nested, err := d.newAttribute(k, sdkPath+".0."+k, elem.SchemaMap()[k], name, block, objectNames)
+				if err != nil {
+					return nil, err
+				}
+
+				o.Attributes = append(o.Attributes, nested)
+			}
+
+			a.Nested = o
+			a.ElementType = fmt.Sprintf("types.ObjectType{AttrTypes: %sAttrTypes}", name)
+
+			if !block {
+				a.SchemaType = "schema." + kind + "Attribute"
+			} else {
+				a.SchemaType = "schema." + kind + "NestedBlock"
+				a.Block = true
+				a.Required, a.Optional, a.Computed = false, false, false
+
+				if s.Computed {
+					a.Comments = append(a.Comments, "TODO: The SDK block is Optional and Computed. Plugin Framework blocks cannot be Computed.")
+				}
+				if s.Required && s.MinItems == 0 {
+					a.Validators = append(a.Validators, fmt.Sprintf("%s.IsRequired()", validatorsPkg))
+					d.use(validatorsPkg)
+				}
+			}
+
+		case *schema.Schema:
+			collectionElem = elem
+			a.SchemaType = "schema." + kind + "Attribute"
+			a.ElementType = elementType(elem.Type)
+
+		default:
+			// Maps without an element type are maps of strings.
+			a.SchemaType = "schema." + kind + "Attribute"
+			a.ElementType = "types.StringType"
+		}
+
+		a.AttrType = fmt.Sprintf("types.%sType{ElemType: %s}", kind, a.ElementType)
+
+		if !inSchema {
+			break
+		}
+
+		if s.MinItems > 0 {
+			a.Validators = append(a.Validators, fmt.Sprintf("%s.SizeAtLeast(%d)", validatorsPkg, s.MinItems))
+			d.use(validatorsPkg)
+		}
+		if s.MaxItems > 0 {
+			a.Validators = append(a.Validators, fmt.Sprintf("%s.SizeAtMost(%d)", validatorsPkg, s.MaxItems))
+			d.use(validatorsPkg)
+		}
+	}
+
+	if !inSchema {
+		return a, nil
+	}
+
+	// Plan modifiers.
+	if s.ForceNew {
+		a.PlanModifiers = append(a.PlanModifiers, lowerKind+"planmodifier.RequiresReplace()")
+	}
+	if s.Computed && !a.Block {
+		a.PlanModifiers = append(a.PlanModifiers, lowerKind+"planmodifier.UseStateForUnknown()")
+	}
+	if len(a.PlanModifiers) > 0 {
+		d.use("planmodifier", lowerKind+"planmodifier")
+	}
+
+	// Validators.
+	for _, v := range []struct {
+		function string
+		paths    []string
+	}{
+		{"ConflictsWith", s.ConflictsWith},
+		{"ExactlyOneOf", without(s.ExactlyOneOf, sdkPath)},
+		{"AtLeastOneOf", without(s.AtLeastOneOf, sdkPath)},
+		{"AlsoRequires", without(s.RequiredWith, sdkPath)},
+	} {
+		if len(v.paths) == 0 {
+			continue
+		}
+
+		expressions := make([]string, len(v.paths))
+		for i, path := range v.paths {
+			expressions[i] = pathExpression(path)
+		}
+
+		a.Validators = append(a.Validators, fmt.Sprintf("%s.%s(%s)", validatorsPkg, v.function, strings.Join(expressions, ", ")))
+		d.use(validatorsPkg, "path")
+	}
+
+	a.TODOs = append(a.TODOs, validatorTODOs("validator", s.ValidateFunc, s.ValidateDiagFunc)...) //nolint:staticcheck // Deprecated fields must also be migrated.
+	if collectionElem != nil {
+		a.TODOs = append(a.TODOs, validatorTODOs("element validator", collectionElem.ValidateFunc, collectionElem.ValidateDiagFunc)...) //nolint:staticcheck // Deprecated fields must also be migrated.
+	}
+	if len(a.Validators) > 0 || len(a.TODOs) > 0 {
+		d.use("validator")
+	}
+
+	// Other behaviors.
+	if s.DiffSuppressFunc != nil {
+		a.Comments = append(a.Comments, fmt.Sprintf("TODO: Migrate SDK DiffSuppressFunc %s, e.g. to a custom type with semantic equality.", funcName(s.DiffSuppressFunc)))
+	}
+	if s.StateFunc != nil {
+		a.Comments = append(a.Comments, fmt.Sprintf("TODO: Migrate SDK StateFunc %s.", funcName(s.StateFunc)))
+	}
+	if s.DefaultFunc != nil {
+		a.Comments = append(a.Comments, fmt.Sprintf("TODO: Migrate SDK DefaultFunc %s, e.g. to a custom Default.", funcName(s.DefaultFunc)))
+	}
+	if s.Set != nil {
+		a.Comments = append(a.Comments, fmt.Sprintf("TODO: The SDK set uses hash function %s. Check that set element equality is unchanged.", funcName(s.Set)))
+	}
+
+	return a, nil
+}
+
+// validatorTODOs returns TODO comments for SDK validator functions, which cannot be introspected.
+func validatorTODOs(kind string, validateFunc schema.SchemaValidateFunc, validateDiagFunc schema.SchemaValidateDiagFunc) []string {
+	var todos []string
+
+	for _, f := range []any{validateFunc, validateDiagFunc} {
+		if reflect.ValueOf(f).IsNil() {
+			continue
+		}
+
+		// Validators wrapped using validation.ToDiagFunc, e.g. by enum.Validate, cannot be identified.
+		if name := funcName(f); name == "validation.ToDiagFunc" {
+			todos = append(todos, fmt.Sprintf("TODO: Migrate SDK %s.", kind))
+		} else {
+			todos = append(todos, fmt.Sprintf("TODO: Migrate SDK %s %s.", kind, name))
+		}
+	}
+
+	return todos
+}
+
+// elementType returns the Plugin Framework element type corresponding to a primitive SDK type.
+func elementType(t schema.ValueType) string {
+	switch t {
+	case schema.TypeBool:
+		return "types.BoolType"
+	case schema.TypeInt:
+		return "types.Int64Type"
+	case schema.TypeFloat:
+		return "types.Float64Type"
+	default:
+		return "types.StringType"
+	}
+}
+
+// defaultExpr returns a static default value expression for an attribute of kind `kind`.
+func defaultExpr(kind string, v any) (string, error) {
+	switch v := v.(type) {
+	case bool:
+		if kind == "Bool" {
+			return fmt.Sprintf("booldefault.StaticBool(%t)", v), nil
+		}
+	case int:
+		switch kind {
+		case "Int64":
+			return fmt.Sprintf("int64default.StaticInt64(%d)", v), nil
+		case "Float64":
+			return fmt.Sprintf("float64default.StaticFloat64(%d)", v), nil
+		}
+	case float64:
+		if kind == "Float64" {
+			return fmt.Sprintf("float64default.StaticFloat64(%s)", strconv.FormatFloat(v, 'g', -1, 64)), nil
+		}
+	case string:
+		if kind == "String" {
+			return fmt.Sprintf("stringdefault.StaticString(%q)", v), nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported %s default value %#v", kind, v)
+}
+
+// pathExpression returns a Plugin Framework path expression for an SDK attribute path, e.g. "monitor.0.alarm_arn".
+func pathExpression(sdkPath string) string {
+	var sb strings.Builder
+
+	for i, step := range strings.Split(sdkPath, ".") {
+		if i == 0 {
+			fmt.Fprintf(&sb, "path.MatchRoot(%q)", step)
+			continue
+		}
+
+		if n, err := strconv.Atoi(step); err == nil {
+			fmt.Fprintf(&sb, ".AtListIndex(%d)", n)
+		} else {
+			fmt.Fprintf(&sb, ".AtName(%q)", step)
+		}
+	}
+
+	return sb.String()
+}
+
+// durationExpr returns a Go duration expression for the first non-nil duration.
+func durationExpr(durations ...*time.Duration) string {
+	for _, d := range durations {
+		if d == nil {
+			continue
+		}
+
+		switch {
+		case *d%time.Hour == 0:
+			return fmt.Sprintf("%d * time.Hour", *d/time.Hour)
+		case *d%time.Minute == 0:
+			return fmt.Sprintf("%d * time.Minute", *d/time.Minute)
+		default:
+			return fmt.Sprintf("%d * time.Second", *d/time.Second)
+		}
+	}
+
+	return ""
+}
+
+// funcName returns the short name of function `f`, e.g. "validation.StringLenBetween".
+func funcName(f any) string {
+	name := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	// Drop any closure suffixes, e.g. the ".func1" of "validation.StringLenBetween.func1".
+	parts := strings.Split(name, ".")
+	for len(parts) > 2 && strings.Trim(strings.TrimPrefix(parts[len(parts)-1], "func"), "0123456789") == "" {
+		parts = parts[:len(parts)-1]
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// objectName returns a unique Go name prefix for the nested object of attribute `tfName`.
+func objectName(tfName, parent string, objectNames map[string]bool) string {
+	name := lowerFirst(goName(tfName))
+
+	if objectNames[name] && parent != "" {
+		name = parent + goName(tfName)
+	}
+
+	for i := 2; objectNames[name]; i++ {
+		name = fmt.Sprintf("%s%d", lowerFirst(goName(tfName)), i)
+	}
+
+	objectNames[name] = true
+
+	return name
+}
+
+// initialisms are the attribute name parts that are upper-cased in Go names.
+var initialisms = map[string]string{
+	"acl":   "ACL",
+	"acls":  "ACLs",
+	"api":   "API",
+	"arn":   "ARN",
+	"arns":  "ARNs",
+	"cidr":  "CIDR",
+	"db":    "DB",
+	"dns":   "DNS",
+	"http":  "HTTP",
+	"https": "HTTPS",
+	"iam":   "IAM",
+	"id":    "ID",
+	"ids":   "IDs",
+	"ip":    "IP",
+	"ips":   "IPs",
+	"json":  "JSON",
+	"kms":   "KMS",
+	"ssl":   "SSL",
+	"tls":   "TLS",
+	"ttl":   "TTL",
+	"uri":   "URI",
+	"url":   "URL",
+	"vpc":   "VPC",
+}
+
+// goName returns the Go name of attribute `tfName`, e.g. "alarm_role_arn" becomes "AlarmRoleARN".
+func goName(tfName string) string {
+	var sb strings.Builder
+
+	for _, part := range strings.Split(tfName, "_") {
+		if part == "" {
+			continue
+		}
+
+		if v, ok := initialisms[part]; ok {
+			sb.WriteString(v)
+		} else {
+			sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+
+	return sb.String()
+}
+
+// lowerFirst lower-cases the first word of a Go name, e.g. "ARNPrefix" becomes "arnPrefix".
+func lowerFirst(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= 'A' && s[i] <= 'Z' {
+		i++
+	}
+
+	switch {
+	case i == 0:
+		return s
+	case i == 1 || i == len(s):
+		return strings.ToLower(s[:i]) + s[i:]
+	default:
+		// Keep the first letter of the next word, e.g. the "P" of "ARNPrefix".
+		return strings.ToLower(s[:i-1]) + s[i-1:]
+	}
+}
+
+// without returns `paths` without `path`.
+func without(paths []string, path string) []string {
+	var v []string
+
+	for _, p := range paths {
+		if p != path {
+			v = append(v, p)
+		}
+	}
+
+	return v
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (d *TemplateData) use(pkgs ...string) {
+	for _, pkg := range pkgs {
+		d.imports[pkg] = true
+	}
+}
@@ -0,0 +1,342 @@
+package frameworkmigrate
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func testResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Second),
+		},
+
+		SchemaVersion: 1,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"configuration.0.use_default_key"},
+						},
+						"use_default_key": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  443,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags":     {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"tags_all": {Type: schema.TypeMap, Optional: true, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+	}
+}
+
+func testOptions() Options {
+	return Options{
+		PackageName:             "example",
+		ProviderNameUpper:       "Example",
+		ResourceName:            "Widget",
+		HumanName:               "Widget",
+		TypeName:                "aws_example_widget",
+		TagsIdentifierAttribute: "arn",
+		SDKProviderVersion:      "5.5.0",
+		ErrorCheckImport:        `"github.com/hashicorp/terraform-provider-aws/names"`,
+		ErrorCheckService:       "names.ExampleEndpointID",
+	}
+}
+
+func TestNewTemplateData(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewTemplateData(testResource(), testOptions())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	attributes := make(map[string]*Attribute)
+	for _, a := range d.Attributes {
+		attributes[a.TFName] = a
+	}
+
+	if got, want := len(attributes), 9; got != want {
+		t.Fatalf("length of Attributes = %v, want %v", got, want)
+	}
+	if got, want := attributes["id"].Schema, "framework.IDAttribute()"; got != want {
+		t.Errorf("id Schema = %v, want %v", got, want)
+	}
+	if got, want := attributes["tags"].Schema, "tftags.TagsAttribute()"; got != want {
+		t.Errorf("tags Schema = %v, want %v", got, want)
+	}
+	if got, want := attributes["arn"].PlanModifiers, []string{"stringplanmodifier.UseStateForUnknown()"}; !equal(got, want) {
+		t.Errorf("arn PlanModifiers = %v, want %v", got, want)
+	}
+	if got, want := attributes["name"].PlanModifiers, []string{"stringplanmodifier.RequiresReplace()"}; !equal(got, want) {
+		t.Errorf("name PlanModifiers = %v, want %v", got, want)
+	}
+	if got, want := attributes["name"].TODOs, []string{"TODO: Migrate SDK validator validation.StringLenBetween."}; !equal(got, want) {
+		t.Errorf("name TODOs = %v, want %v", got, want)
+	}
+	if got, want := attributes["port"].Default, "int64default.StaticInt64(443)"; got != want {
+		t.Errorf("port Default = %v, want %v", got, want)
+	}
+	if got, want := attributes["port"].Computed, true; got != want {
+		t.Errorf("port Computed = %v, want %v", got, want)
+	}
+	if got, want := attributes["security_group_ids"].SchemaType, "schema.SetAttribute"; got != want {
+		t.Errorf("security_group_ids SchemaType = %v, want %v", got, want)
+	}
+	if got, want := attributes["security_group_ids"].ElementType, "types.StringType"; got != want {
+		t.Errorf("security_group_ids ElementType = %v, want %v", got, want)
+	}
+
+	configuration := attributes["configuration"]
+	if got, want := configuration.SchemaType, "schema.ListNestedBlock"; got != want {
+		t.Errorf("configuration SchemaType = %v, want %v", got, want)
+	}
+	if got, want := configuration.Validators, []string{"listvalidator.SizeAtMost(1)"}; !equal(got, want) {
+		t.Errorf("configuration Validators = %v, want %v", got, want)
+	}
+	if got, want := configuration.Nested.Attributes[0].Validators, []string{`stringvalidator.ConflictsWith(path.MatchRoot("configuration").AtListIndex(0).AtName("use_default_key"))`}; !equal(got, want) {
+		t.Errorf("configuration.kms_key_id Validators = %v, want %v", got, want)
+	}
+
+	status := attributes["status"]
+	if got, want := status.SchemaType, "schema.ListAttribute"; got != want {
+		t.Errorf("status SchemaType = %v, want %v", got, want)
+	}
+	if got, want := status.ElementType, "types.ObjectType{AttrTypes: statusAttrTypes}"; got != want {
+		t.Errorf("status ElementType = %v, want %v", got, want)
+	}
+	if got, want := len(status.Nested.Attributes[0].PlanModifiers), 0; got != want {
+		t.Errorf("length of status.reason PlanModifiers = %v, want %v", got, want)
+	}
+
+	if got, want := d.Timeouts.Create, "30 * time.Minute"; got != want {
+		t.Errorf("Timeouts.Create = %v, want %v", got, want)
+	}
+	if got, want := d.Timeouts.Delete, "90 * time.Second"; got != want {
+		t.Errorf("Timeouts.Delete = %v, want %v", got, want)
+	}
+	if got, want := d.ImportPassthrough, true; got != want {
+		t.Errorf("ImportPassthrough = %v, want %v", got, want)
+	}
+	if got, want := d.HasTags, true; got != want {
+		t.Errorf("HasTags = %v, want %v", got, want)
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewTemplateData(testResource(), testOptions())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, tc := range []struct {
+		name     string
+		body     string
+		contains []string
+	}{
+		{
+			name: "resource",
+			body: ResourceTemplate,
+			contains: []string{
+				"r.SetMigratedFromPluginSDK(true)",
+				"r.SetDefaultCreateTimeout(30 * time.Minute)",
+				"framework.WithImportByID",
+				"Version: 1,",
+				`"use_default_key": schema.BoolAttribute{`,
+				"Default:  booldefault.StaticBool(false),",
+				`"timeouts": timeouts.Block(ctx, timeouts.Opts{`,
+				"r.SetTagsAll(ctx, request, response)",
+				"Configuration    types.List     `tfsdk:\"configuration\"`",
+				`"reason": types.StringType,`,
+			},
+		},
+		{
+			name: "test",
+			body: ResourceTestTemplate,
+			contains: []string{
+				"func TestAccExampleWidget_frameworkMigration(t *testing.T) {",
+				`VersionConstraint: "5.5.0",`,
+				"ErrorCheck:   acctest.ErrorCheck(t, names.ExampleEndpointID),",
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl, err := template.New(tc.name).Parse(tc.body)
+
+			if err != nil {
+				t.Fatalf("parsing template: %s", err)
+			}
+
+			var buffer bytes.Buffer
+
+			if err := tmpl.Execute(&buffer, d); err != nil {
+				t.Fatalf("executing template: %s", err)
+			}
+
+			body, err := format.Source(buffer.Bytes())
+
+			if err != nil {
+				t.Fatalf("formatting generated code: %s\n%s", err, buffer.String())
+			}
+
+			for _, v := range tc.contains {
+				if !strings.Contains(string(body), v) {
+					t.Errorf("generated code does not contain %q\n%s", v, body)
+				}
+			}
+		})
+	}
+}
+
+func TestGoName(t *testing.T) {
+	t.Parallel()
+
+	for input, want := range map[string]string{
+		"name":               "Name",
+		"alarm_role_arn":     "AlarmRoleARN",
+		"security_group_ids": "SecurityGroupIDs",
+		"vpc_id":             "VPCID",
+	} {
+		if got := goName(input); got != want {
+			t.Errorf("goName(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestDefaultNames(t *testing.T) {
+	t.Parallel()
+
+	if got, want := DefaultResourceName("aws_vpclattice_service_network", "vpclattice"), "ServiceNetwork"; got != want {
+		t.Errorf("DefaultResourceName = %v, want %v", got, want)
+	}
+	if got, want := DefaultHumanName("aws_vpclattice_service_network", "vpclattice"), "Service Network"; got != want {
+		t.Errorf("DefaultHumanName = %v, want %v", got, want)
+	}
+	if got, want := DefaultHumanName("aws_ec2_vpc_ipam", "ec2"), "VPC Ipam"; got != want {
+		t.Errorf("DefaultHumanName = %v, want %v", got, want)
+	}
+}
+
+func TestFuncName(t *testing.T) {
+	t.Parallel()
+
+	if got, want := funcName(validation.StringLenBetween(1, 2)), "validation.StringLenBetween"; got != want {
+		t.Errorf("funcName = %v, want %v", got, want)
+	}
+	if got, want := funcName(schema.ImportStatePassthroughContext), "schema.ImportStatePassthroughContext"; got != want {
+		t.Errorf("funcName = %v, want %v", got, want)
+	}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestTemplatesWithoutErrorCheck(t *testing.T) {
+	t.Parallel()
+
+	opts := testOptions()
+	opts.ErrorCheckImport = ""
+	opts.ErrorCheckService = ""
+
+	d, err := NewTemplateData(testResource(), opts)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tmpl, err := template.New("test").Parse(ResourceTestTemplate)
+
+	if err != nil {
+		t.Fatalf("parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+
+	if err := tmpl.Execute(&buffer, d); err != nil {
+		t.Fatalf("executing template: %s", err)
+	}
+
+	body, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		t.Fatalf("formatting generated code: %s\n%s", err, buffer.String())
+	}
+
+	if strings.Contains(string(body), "ErrorCheck") {
+		t.Errorf("generated code contains ErrorCheck\n%s", body)
+	}
+}
@@ -0,0 +1,229 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/frameworkmigrate"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	typeName     = flag.String("resource", "", "Terraform resource type name of the Plugin SDK resource to migrate, e.g. aws_appconfig_application")
+	resourceName = flag.String("name", "", "Go name of the migrated resource, e.g. Application (default derived from the resource type name)")
+	sdkVersion   = flag.String("sdk-version", "", "last provider version in which the resource is implemented using the Plugin SDK, e.g. 5.5.0")
+	force        = flag.Bool("f", false, "overwrite existing files")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+
+	if *typeName == "" || *sdkVersion == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+	if servicePackage == "" {
+		wd, err := os.Getwd()
+
+		if err != nil {
+			g.Fatalf("error getting working directory: %s", err)
+		}
+
+		servicePackage = filepath.Base(wd)
+	}
+
+	ctx := context.Background()
+
+	var sdkResource *types.ServicePackageSDKResource
+	for _, sp := range provider.ServicePackages(ctx) {
+		if sp.ServicePackageName() != servicePackage {
+			continue
+		}
+
+		for _, v := range sp.SDKResources(ctx) {
+			if v.TypeName == *typeName {
+				sdkResource = v
+				break
+			}
+		}
+	}
+
+	if sdkResource == nil {
+		g.Fatalf("Plugin SDK resource %s not found in service package %s", *typeName, servicePackage)
+	}
+
+	providerNameUpper, err := names.ProviderNameUpper(servicePackage)
+
+	if err != nil {
+		g.Fatalf("error getting service name: %s", err)
+	}
+
+	opts := frameworkmigrate.Options{
+		PackageName:        servicePackage,
+		ProviderNameUpper:  providerNameUpper,
+		ResourceName:       *resourceName,
+		HumanName:          sdkResource.Name,
+		TypeName:           *typeName,
+		SDKProviderVersion: *sdkVersion,
+	}
+
+	if opts.ResourceName == "" {
+		opts.ResourceName = frameworkmigrate.DefaultResourceName(*typeName, servicePackage)
+	}
+	if opts.HumanName == "" {
+		opts.HumanName = frameworkmigrate.DefaultHumanName(*typeName, servicePackage)
+	}
+	if v := sdkResource.Tags; v != nil {
+		opts.TagsIdentifierAttribute = v.IdentifierAttribute
+	}
+
+	// Use the same service identifier as the existing acceptance tests, falling back to the AWS SDK for Go v1 endpoint ID.
+	// Without either, the generated test has no ErrorCheck.
+	opts.ErrorCheckService, opts.ErrorCheckImport = errorCheckService(".")
+	if opts.ErrorCheckService == "" {
+		if v, err := names.AWSGoV1Package(servicePackage); err == nil && v != "" {
+			opts.ErrorCheckService = fmt.Sprintf("%s.EndpointsID", v)
+			opts.ErrorCheckImport = strconv.Quote("github.com/aws/aws-sdk-go/service/" + v)
+		}
+	}
+
+	td, err := frameworkmigrate.NewTemplateData(sdkResource.Factory(), opts)
+
+	if err != nil {
+		g.Fatalf("error migrating %s: %s", *typeName, err)
+	}
+
+	base := strings.TrimPrefix(strings.TrimPrefix(*typeName, "aws_"), servicePackage+"_") + "_framework"
+
+	for _, v := range []struct {
+		filename string
+		name     string
+		body     string
+	}{
+		{base + ".go", "resource", frameworkmigrate.ResourceTemplate},
+		{base + "_test.go", "resourcetest", frameworkmigrate.ResourceTestTemplate},
+	} {
+		if _, err := os.Stat(v.filename); err == nil && !*force {
+			g.Fatalf("file (%s) already exists, use -f to overwrite", v.filename)
+		}
+
+		g.Infof("Generating internal/service/%s/%s", servicePackage, v.filename)
+
+		d := g.NewGoFileDestination(v.filename)
+
+		if err := d.WriteTemplate(v.name, v.body, td); err != nil {
+			g.Fatalf("error generating %s: %s", v.filename, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", v.filename, err)
+		}
+	}
+}
+
+// errorCheckService returns the service identifier passed to acctest.ErrorCheck by existing acceptance tests in `dir`
+// together with the import spec it requires, or "" if there are none.
+func errorCheckService(dir string) (string, string) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi os.FileInfo) bool {
+		return strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+
+	if err != nil {
+		return "", ""
+	}
+
+	for _, pkg := range pkgs {
+		filenames := make([]string, 0, len(pkg.Files))
+		for filename := range pkg.Files {
+			filenames = append(filenames, filename)
+		}
+		sort.Strings(filenames)
+
+		for _, filename := range filenames {
+			f := pkg.Files[filename]
+			var service, spec string
+
+			ast.Inspect(f, func(n ast.Node) bool {
+				if service != "" {
+					return false
+				}
+
+				call, ok := n.(*ast.CallExpr)
+				if !ok || len(call.Args) != 2 {
+					return true
+				}
+
+				fun, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok || fun.Sel.Name != "ErrorCheck" {
+					return true
+				}
+
+				if x, ok := fun.X.(*ast.Ident); !ok || x.Name != "acctest" {
+					return true
+				}
+
+				arg, ok := call.Args[1].(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+
+				x, ok := arg.X.(*ast.Ident)
+				if !ok {
+					return true
+				}
+
+				for _, imp := range f.Imports {
+					path, err := strconv.Unquote(imp.Path.Value)
+					if err != nil {
+						continue
+					}
+
+					if imp.Name != nil && imp.Name.Name == x.Name {
+						service, spec = x.Name+"."+arg.Sel.Name, imp.Name.Name+" "+imp.Path.Value
+						break
+					}
+
+					if imp.Name == nil && path[strings.LastIndex(path, "/")+1:] == x.Name {
+						service, spec = x.Name+"."+arg.Sel.Name, imp.Path.Value
+						break
+					}
+				}
+
+				return service == ""
+			})
+
+			if service != "" {
+				return service, spec
+			}
+		}
+	}
+
+	return "", ""
+}
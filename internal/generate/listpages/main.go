@@ -10,11 +10,13 @@ import (
 	"fmt"
 	"go/ast"
 	"go/format"
+	"go/types"
 	"html/template"
 	"log"
 	"os"
 	"sort"
 	"strings"
+	texttemplate "text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"golang.org/x/tools/go/packages"
@@ -22,6 +24,9 @@ import (
 
 const (
 	defaultFilename = "list_pages_gen.go"
+
+	sdkV1 = 1
+	sdkV2 = 2
 )
 
 var (
@@ -30,6 +35,8 @@ var (
 	outputPaginator = flag.String("OutputPaginator", "", "name of the output pagination token field")
 	paginator       = flag.String("Paginator", "NextToken", "name of the pagination token field")
 	export          = flag.Bool("Export", false, "whether to export the list functions")
+	sdkVersion      = flag.Int("AWSSDKVersion", sdkV1, "Version of the AWS SDK Go to use i.e. 1 or 2")
+	find            = flag.Bool("Find", false, "whether to generate find functions (AWS SDK Go v2 only)")
 )
 
 func usage() {
@@ -49,6 +56,14 @@ func main() {
 		log.Fatal("both InputPaginator and OutputPaginator must be specified if one is")
 	}
 
+	if *sdkVersion != sdkV1 && *sdkVersion != sdkV2 {
+		log.Fatalf("AWS SDK Go Version %d not supported", *sdkVersion)
+	}
+
+	if *find && *sdkVersion != sdkV2 {
+		log.Fatal("Find is only supported for AWS SDK Go v2")
+	}
+
 	if *inputPaginator == "" {
 		*inputPaginator = *paginator
 	}
@@ -64,7 +79,7 @@ func main() {
 	servicePackage := os.Getenv("GOPACKAGE")
 	log.SetPrefix(fmt.Sprintf("generate/listpage: %s: ", servicePackage))
 
-	awsService, err := names.AWSGoV1Package(servicePackage)
+	awsService, err := names.AWSGoPackage(servicePackage, *sdkVersion)
 
 	if err != nil {
 		log.Fatalf("encountered: %s", err)
@@ -73,6 +88,16 @@ func main() {
 	functions := strings.Split(*listOps, ",")
 	sort.Strings(functions)
 
+	if *sdkVersion == sdkV2 {
+		src := generateV2(servicePackage, awsService, functions)
+
+		if err := os.WriteFile(filename, src, 0644); err != nil {
+			log.Fatalf("error writing output: %s", err)
+		}
+
+		return
+	}
+
 	g := Generator{
 		tmpl:            template.Must(template.New("function").Parse(functionTemplate)),
 		inputPaginator:  *inputPaginator,
@@ -361,3 +386,277 @@ func fixSomeInitialisms(s string) string {
 
 	return replace
 }
+
+type HeaderInfoV2 struct {
+	Parameters         string
+	DestinationPackage string
+	Imports            []string
+}
+
+type FuncSpecV2 struct {
+	Name            string
+	PaginatorName   string
+	FindName        string
+	FindFirstName   string
+	AWSName         string
+	ClientType      string
+	InputType       string
+	OutputType      string
+	InputPaginator  string
+	OutputPaginator string
+	ItemsField      string
+	ItemType        string
+}
+
+// generateV2 generates paginated variants of the AWS SDK for Go v2 operations in `functions`.
+// Each function is either an operation name or an operation name and the output field containing the operation's items,
+// separated by a colon, e.g. ListContactLists:ContactLists.
+func generateV2(servicePackage, awsService string, functions []string) []byte {
+	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%[1]s", awsService)
+	typesPackage := sourcePackage + "/types"
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes,
+	}
+	pkgs, err := packages.Load(cfg, sourcePackage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+	pkg := pkgs[0].Types
+
+	imports := map[string]string{}
+	qualifier := func(p *types.Package) string {
+		switch p.Path() {
+		case sourcePackage:
+			return p.Name()
+		case typesPackage:
+			imports[p.Path()] = "awstypes"
+			return "awstypes"
+		default:
+			imports[p.Path()] = p.Name()
+			return p.Name()
+		}
+	}
+
+	var g Generator
+
+	specs := make([]FuncSpecV2, 0, len(functions))
+	findNames := map[string]string{}
+	for _, function := range functions {
+		awsName, itemsField, _ := strings.Cut(function, ":")
+		spec := funcSpecV2(pkg, awsName, itemsField, qualifier)
+
+		// Operations that return the same item type would generate the same FindFirst function.
+		for _, name := range []string{spec.FindName, spec.FindFirstName} {
+			if name == "" {
+				continue
+			}
+			if v, ok := findNames[name]; ok {
+				log.Fatalf("functions \"%s\" and \"%s\" both generate \"%s\"", v, awsName, name)
+			}
+			findNames[name] = awsName
+		}
+
+		specs = append(specs, spec)
+	}
+
+	importSpecs := []string{
+		fmt.Sprintf("%q", sourcePackage),
+		`"github.com/hashicorp/terraform-provider-aws/internal/pagination"`,
+	}
+	for path, name := range imports {
+		if name == "awstypes" {
+			importSpecs = append(importSpecs, fmt.Sprintf("awstypes %q", path))
+		} else {
+			importSpecs = append(importSpecs, fmt.Sprintf("%q", path))
+		}
+	}
+	if *find {
+		importSpecs = append(importSpecs, `tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"`)
+	}
+	sort.Slice(importSpecs, func(i, j int) bool {
+		return importPath(importSpecs[i]) < importPath(importSpecs[j])
+	})
+
+	header := texttemplate.Must(texttemplate.New("header").Parse(headerV2Template))
+	err = header.Execute(&g.buf, HeaderInfoV2{
+		Parameters:         strings.Join(os.Args[1:], " "),
+		DestinationPackage: servicePackage,
+		Imports:            importSpecs,
+	})
+	if err != nil {
+		log.Fatalf("error writing header: %s", err)
+	}
+
+	function := texttemplate.Must(texttemplate.New("function").Parse(functionV2Template))
+	for _, spec := range specs {
+		if err := function.Execute(&g.buf, spec); err != nil {
+			log.Fatalf("error writing function \"%s\": %s", spec.AWSName, err)
+		}
+	}
+
+	return g.format()
+}
+
+func funcSpecV2(pkg *types.Package, awsName, itemsField string, qualifier types.Qualifier) FuncSpecV2 {
+	client := pkg.Scope().Lookup("Client")
+	if client == nil {
+		log.Fatalf("type \"Client\" not found in %s", pkg.Path())
+	}
+
+	if obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(client.Type()), true, pkg, awsName); obj == nil {
+		log.Fatalf("function \"%s\" not found", awsName)
+	}
+
+	// Use the SDK's paginator where there is one.
+	if pkg.Scope().Lookup("New"+awsName+"Paginator") != nil {
+		log.Fatalf("function \"%s\" has an AWS SDK for Go v2 paginator, use %s.New%[1]sPaginator", awsName, pkg.Name())
+	}
+
+	input := lookupStruct(pkg, awsName+"Input")
+	output := lookupStruct(pkg, awsName+"Output")
+
+	checkPaginator(input, awsName+"Input", *inputPaginator)
+	checkPaginator(output, awsName+"Output", *outputPaginator)
+
+	name := fixSomeInitialisms(awsName)
+	if !*export {
+		name = fmt.Sprintf("%s%s", strings.ToLower(name[0:1]), name[1:])
+	}
+
+	spec := FuncSpecV2{
+		Name:            name,
+		PaginatorName:   fmt.Sprintf("new%sPaginator", fixSomeInitialisms(awsName)),
+		AWSName:         awsName,
+		ClientType:      fmt.Sprintf("*%s.Client", pkg.Name()),
+		InputType:       fmt.Sprintf("%s.%sInput", pkg.Name(), awsName),
+		OutputType:      fmt.Sprintf("*%s.%sOutput", pkg.Name(), awsName),
+		InputPaginator:  *inputPaginator,
+		OutputPaginator: *outputPaginator,
+	}
+
+	if !*find {
+		return spec
+	}
+
+	var items *types.Var
+	for i := 0; i < output.NumFields(); i++ {
+		field := output.Field(i)
+
+		if _, ok := field.Type().(*types.Slice); !ok {
+			continue
+		}
+
+		if itemsField == "" {
+			if items != nil {
+				log.Fatalf("function \"%s\" has multiple item fields, specify one as %[1]s:<field-name>", awsName)
+			}
+			items = field
+		} else if field.Name() == itemsField {
+			items = field
+		}
+	}
+
+	if items == nil {
+		log.Fatalf("item field not found for function \"%s\"", awsName)
+	}
+
+	// Name the find functions for the operation and item type, e.g. ListKxClusters returning KxCluster items
+	// generates findKxClusters and findFirstKxCluster.
+	itemType := items.Type().(*types.Slice).Elem()
+	findName := fmt.Sprintf("Find%s", fixSomeInitialisms(operationNoun(awsName)))
+	findFirstName := fmt.Sprintf("FindFirst%s", fixSomeInitialisms(itemTypeName(itemType, awsName)))
+	if !*export {
+		findName = fmt.Sprintf("%s%s", strings.ToLower(findName[0:1]), findName[1:])
+		findFirstName = fmt.Sprintf("%s%s", strings.ToLower(findFirstName[0:1]), findFirstName[1:])
+	}
+
+	spec.FindName = findName
+	spec.FindFirstName = findFirstName
+	spec.ItemsField = items.Name()
+	spec.ItemType = types.TypeString(itemType, qualifier)
+
+	return spec
+}
+
+// operationNoun returns the operation name `awsName` without its verb, e.g. KxClusters for ListKxClusters.
+func operationNoun(awsName string) string {
+	for _, verb := range []string{"Describe", "Get", "List", "Search"} {
+		if v := strings.TrimPrefix(awsName, verb); v != awsName && v != "" {
+			return v
+		}
+	}
+
+	return awsName
+}
+
+// itemTypeName returns the name of the item type `typ`, e.g. KxCluster.
+// Unnamed item types, e.g. string, are named for the operation `awsName` in the singular.
+func itemTypeName(typ types.Type, awsName string) string {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	if named, ok := typ.(*types.Named); ok {
+		return named.Obj().Name()
+	}
+
+	noun := operationNoun(awsName)
+	if v := strings.TrimSuffix(noun, "ies"); v != noun {
+		return v + "y"
+	}
+
+	return strings.TrimSuffix(noun, "s")
+}
+
+// importPath returns the path of the import spec `spec`.
+func importPath(spec string) string {
+	_, path, ok := strings.Cut(spec, " ")
+	if !ok {
+		return spec
+	}
+
+	return path
+}
+
+func lookupStruct(pkg *types.Package, name string) *types.Struct {
+	obj := pkg.Scope().Lookup(name)
+	if obj == nil {
+		log.Fatalf("type \"%s\" not found", name)
+	}
+
+	s, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok {
+		log.Fatalf("type \"%s\" is not a struct", name)
+	}
+
+	return s
+}
+
+// checkPaginator verifies that the struct type `s` has a string pointer pagination token field named `paginator`.
+func checkPaginator(s *types.Struct, name, paginator string) {
+	for i := 0; i < s.NumFields(); i++ {
+		field := s.Field(i)
+
+		if field.Name() != paginator {
+			continue
+		}
+
+		if types.TypeString(field.Type(), nil) != "*string" {
+			log.Fatalf("pagination token field \"%s.%s\" has unsupported type %s", name, paginator, field.Type())
+		}
+
+		return
+	}
+
+	log.Fatalf("pagination token field \"%s.%s\" not found", name, paginator)
+}
+
+//go:embed header_v2.tmpl
+var headerV2Template string
+
+//go:embed function_v2.tmpl
+var functionV2Template string
@@ -0,0 +1,102 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func main() {
+	const (
+		servicePackagesFile = `service_packages_gen.go`
+		servicePackagePath  = `github.com/hashicorp/terraform-provider-aws/internal/service/`
+	)
+	filename := `schema_gen_test.go`
+
+	flag.Parse()
+	args := flag.Args()
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
+	g := common.NewGenerator()
+
+	packageName := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating %s/%s", packageName, filename)
+
+	// The service packages registered with the provider are those imported by the generated service packages list.
+	f, err := parser.ParseFile(token.NewFileSet(), servicePackagesFile, nil, parser.ImportsOnly)
+
+	if err != nil {
+		g.Fatalf("error parsing %s: %s", servicePackagesFile, err)
+	}
+
+	td := TemplateData{
+		PackageName: packageName,
+	}
+
+	for _, v := range f.Imports {
+		importPath, err := strconv.Unquote(v.Path.Value)
+
+		if err != nil {
+			g.Fatalf("error parsing %s: %s", servicePackagesFile, err)
+		}
+
+		if !strings.HasPrefix(importPath, servicePackagePath) {
+			continue
+		}
+
+		p := path.Base(importPath)
+		s := ServiceDatum{
+			ProviderPackage:   p,
+			ProviderNameUpper: strings.ToUpper(p[:1]) + p[1:],
+		}
+
+		// Pseudo-services such as "meta" have no service data.
+		if v, err := names.ProviderNameUpper(p); err == nil {
+			s.ProviderNameUpper = v
+		}
+
+		td.Services = append(td.Services, s)
+	}
+
+	sort.SliceStable(td.Services, func(i, j int) bool {
+		return td.Services[i].ProviderPackage < td.Services[j].ProviderPackage
+	})
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("schematests", tmpl, td); err != nil {
+		g.Fatalf("error generating resource schema tests: %s", err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+type ServiceDatum struct {
+	ProviderPackage   string
+	ProviderNameUpper string
+}
+
+type TemplateData struct {
+	PackageName string
+	Services    []ServiceDatum
+}
+
+//go:embed file.tmpl
+var tmpl string
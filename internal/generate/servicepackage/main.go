@@ -12,6 +12,7 @@ import (
 	"os"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -125,6 +126,8 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	ConcurrencyLimit        string
+	ConcurrencyKey          string
 }
 
 type ServiceDatum struct {
@@ -202,12 +205,34 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging and concurrency annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Concurrency" {
+			args := common.ParseArgs(m[3])
+
+			if d.ConcurrencyLimit != "" {
+				v.err = multierror.Append(v.err, fmt.Errorf("multiple Concurrency annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if attr, ok := args.Keyword["limit"]; ok {
+				if limit, err := strconv.ParseInt(attr, 10, 64); err != nil || limit <= 0 {
+					v.err = multierror.Append(v.err, fmt.Errorf("invalid Concurrency limit (%s): %s", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				}
+
+				d.ConcurrencyLimit = attr
+			} else {
+				v.err = multierror.Append(v.err, fmt.Errorf("no Concurrency limit: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if attr, ok := args.Keyword["key"]; ok {
+				d.ConcurrencyKey = attr
+			}
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -278,7 +303,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "Concurrency", "Tags":
 				// Handled above.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
@@ -12,8 +12,10 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/hcl/v2/hclsimple"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/report"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -24,12 +26,18 @@ type ServiceDatum struct {
 	VpcLock         bool
 	Parallelism     int
 	Region          string
+	Duration        time.Duration // From the acceptance test report, if any.
+	APICalls        int           // From the acceptance test report, if any.
 }
 
 type TemplateData struct {
 	Services []ServiceDatum
 }
 
+const (
+	acctestReportFileEnvVar = "TF_ACC_REPORT_FILE"
+)
+
 func main() {
 	const (
 		servicesAllFile   = `../../../.teamcity/components/generated/services_all.kt`
@@ -46,6 +54,21 @@ func main() {
 		g.Fatalf("error reading %s: %s", serviceConfigFile, err)
 	}
 
+	// Services are ordered by decreasing cost and duration if an acceptance test report is available.
+	var serviceReports map[string]report.Service
+	if reportFile := os.Getenv(acctestReportFileEnvVar); reportFile != "" {
+		tests, err := report.Read(reportFile)
+
+		if err != nil {
+			g.Fatalf("error reading %s: %s", reportFile, err)
+		}
+
+		serviceReports = make(map[string]report.Service)
+		for _, v := range report.Summarize(tests).Services {
+			serviceReports[v.Package] = v
+		}
+	}
+
 	data, err := common.ReadAllCSVData(namesDataFile)
 
 	if err != nil {
@@ -88,6 +111,11 @@ func main() {
 			sd.Region = serviceConfig.Region
 		}
 
+		if v, ok := serviceReports[p]; ok {
+			sd.Duration = time.Duration(v.DurationMillis) * time.Millisecond
+			sd.APICalls = v.APICalls
+		}
+
 		if serviceConfig.Skip {
 			g.Infof("Skipping service %q...", p)
 			continue
@@ -97,6 +125,12 @@ func main() {
 	}
 
 	sort.SliceStable(td.Services, func(i, j int) bool {
+		if a, b := td.Services[i].Duration, td.Services[j].Duration; a != b {
+			return a > b
+		}
+		if a, b := td.Services[i].APICalls, td.Services[j].APICalls; a != b {
+			return a > b
+		}
 		return td.Services[i].ProviderPackage < td.Services[j].ProviderPackage
 	})
 
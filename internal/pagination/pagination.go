@@ -0,0 +1,136 @@
+package pagination
+
+import (
+	"context"
+
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// ListFunc calls an AWS API list operation.
+type ListFunc[I, O any] func(context.Context, *I) (O, error)
+
+// Paginator iterates over the pages of results returned by an AWS API list operation.
+// It complements the paginators generated for the AWS SDK for Go v2 and has the same HasMorePages/NextPage interface.
+type Paginator[I, O any] struct {
+	input       *I
+	list        ListFunc[I, O]
+	inputToken  func(*I, *string)
+	outputToken func(O) *string
+	nextToken   *string
+	firstPage   bool
+}
+
+// New returns a Paginator that calls `list` with a copy of `input`.
+// `inputToken` sets the pagination token on the input and `outputToken` gets the pagination token from the output.
+func New[I, O any](input *I, list ListFunc[I, O], inputToken func(*I, *string), outputToken func(O) *string) *Paginator[I, O] {
+	if input == nil {
+		input = new(I)
+	}
+
+	return &Paginator[I, O]{
+		input:       input,
+		list:        list,
+		inputToken:  inputToken,
+		outputToken: outputToken,
+		firstPage:   true,
+	}
+}
+
+// HasMorePages returns whether more pages are available.
+func (p *Paginator[I, O]) HasMorePages() bool {
+	return p.firstPage || (p.nextToken != nil && *p.nextToken != "")
+}
+
+// NextPage retrieves the next page of results.
+// Pagination stops if the operation returns the pagination token that it was called with.
+func (p *Paginator[I, O]) NextPage(ctx context.Context) (O, error) {
+	input := *p.input
+	p.inputToken(&input, p.nextToken)
+
+	output, err := p.list(ctx, &input)
+
+	if err != nil {
+		var zero O
+		return zero, err
+	}
+
+	prevToken := p.nextToken
+	p.firstPage = false
+	p.nextToken = p.outputToken(output)
+
+	if prevToken != nil && p.nextToken != nil && *prevToken == *p.nextToken {
+		p.nextToken = nil
+	}
+
+	return output, nil
+}
+
+// Pages calls `fn` with each page of results until there are no more pages or `fn` returns false.
+// The second argument to `fn` is true for the last page.
+func Pages[I, O any](ctx context.Context, p *Paginator[I, O], fn func(O, bool) bool) error {
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		if !fn(page, !p.HasMorePages()) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// Find returns the items from all pages of results for which `filter` returns true.
+func Find[I, O, T any](ctx context.Context, p *Paginator[I, O], items func(O) []T, filter tfslices.FilterFunc[T]) ([]T, error) {
+	var output []T
+
+	err := Pages(ctx, p, func(page O, lastPage bool) bool {
+		for _, v := range items(page) {
+			if filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindFirst returns the first item for which `filter` returns true.
+// No further pages of results are retrieved once a matching item is found.
+// A tfresource.EmptyResultError is returned if there is no matching item.
+func FindFirst[I, O, T any](ctx context.Context, p *Paginator[I, O], items func(O) []T, filter tfslices.FilterFunc[T]) (*T, error) {
+	var output *T
+
+	err := Pages(ctx, p, func(page O, lastPage bool) bool {
+		for _, v := range items(page) {
+			if filter(v) {
+				v := v
+				output = &v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(p.input)
+	}
+
+	return output, nil
+}
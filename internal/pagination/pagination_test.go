@@ -0,0 +1,337 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testInput struct {
+	Filter    string
+	NextToken *string
+}
+
+type testOutput struct {
+	Items     []string
+	NextToken *string
+}
+
+type testPage struct {
+	items     []string
+	nextToken string
+}
+
+// testLister simulates an AWS API list operation returning `pages` keyed by input pagination token.
+type testLister struct {
+	pages map[string]testPage
+	err   error
+	calls []string
+}
+
+func (l *testLister) list(_ context.Context, input *testInput) (*testOutput, error) {
+	var token string
+	if input.NextToken != nil {
+		token = *input.NextToken
+	}
+
+	l.calls = append(l.calls, token)
+
+	if l.err != nil {
+		return nil, l.err
+	}
+
+	page := l.pages[token]
+	output := &testOutput{
+		Items: page.items,
+	}
+	if page.nextToken != "" {
+		output.NextToken = &page.nextToken
+	}
+
+	return output, nil
+}
+
+func (l *testLister) paginator(input *testInput) *Paginator[testInput, *testOutput] {
+	return New(input, l.list, func(input *testInput, token *string) {
+		input.NextToken = token
+	}, func(output *testOutput) *string {
+		return output.NextToken
+	})
+}
+
+func testItems(output *testOutput) []string {
+	return output.Items
+}
+
+func newTestLister() *testLister {
+	return &testLister{
+		pages: map[string]testPage{
+			"":   {items: []string{"alpha", "bravo"}, nextToken: "t1"},
+			"t1": {items: []string{}, nextToken: "t2"},
+			"t2": {items: []string{"charlie", "alpine"}},
+		},
+	}
+}
+
+func TestPaginator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		pages         map[string]testPage
+		expectedItems []string
+		expectedCalls []string
+	}
+	tests := map[string]testCase{
+		"single page": {
+			pages: map[string]testPage{
+				"": {items: []string{"alpha"}},
+			},
+			expectedItems: []string{"alpha"},
+			expectedCalls: []string{""},
+		},
+		"multiple pages": {
+			pages:         newTestLister().pages,
+			expectedItems: []string{"alpha", "bravo", "charlie", "alpine"},
+			expectedCalls: []string{"", "t1", "t2"},
+		},
+		"duplicate token": {
+			pages: map[string]testPage{
+				"":   {items: []string{"alpha"}, nextToken: "t1"},
+				"t1": {items: []string{"bravo"}, nextToken: "t1"},
+			},
+			expectedItems: []string{"alpha", "bravo"},
+			expectedCalls: []string{"", "t1"},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			l := &testLister{pages: test.pages}
+			input := &testInput{Filter: "a"}
+			p := l.paginator(input)
+
+			var got []string
+			for p.HasMorePages() {
+				page, err := p.NextPage(ctx)
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				got = append(got, page.Items...)
+			}
+
+			if diff := cmp.Diff(got, test.expectedItems); diff != "" {
+				t.Errorf("unexpected items diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(l.calls, test.expectedCalls); diff != "" {
+				t.Errorf("unexpected calls diff (+wanted, -got): %s", diff)
+			}
+			if input.NextToken != nil {
+				t.Errorf("input modified: NextToken = %v", *input.NextToken)
+			}
+		})
+	}
+}
+
+func TestPaginatorNilInput(t *testing.T) {
+	t.Parallel()
+
+	l := newTestLister()
+	p := l.paginator(nil)
+
+	if _, err := p.NextPage(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(l.calls), 1; got != want {
+		t.Errorf("length of calls = %v, want %v", got, want)
+	}
+}
+
+func TestPages(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("all pages", func(t *testing.T) {
+		t.Parallel()
+
+		l := newTestLister()
+		var lastPages []bool
+
+		err := Pages(ctx, l.paginator(&testInput{}), func(page *testOutput, lastPage bool) bool {
+			lastPages = append(lastPages, lastPage)
+			return true
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if diff := cmp.Diff(lastPages, []bool{false, false, true}); diff != "" {
+			t.Errorf("unexpected lastPage diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("early exit", func(t *testing.T) {
+		t.Parallel()
+
+		l := newTestLister()
+
+		err := Pages(ctx, l.paginator(&testInput{}), func(page *testOutput, lastPage bool) bool {
+			return false
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if diff := cmp.Diff(l.calls, []string{""}); diff != "" {
+			t.Errorf("unexpected calls diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		l := newTestLister()
+		l.err = errors.New("test error")
+
+		err := Pages(ctx, l.paginator(&testInput{}), func(page *testOutput, lastPage bool) bool {
+			t.Error("unexpected call to fn")
+			return true
+		})
+
+		if !errors.Is(err, l.err) {
+			t.Errorf("err = %v, want %v", err, l.err)
+		}
+	})
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("matches", func(t *testing.T) {
+		t.Parallel()
+
+		l := newTestLister()
+
+		got, err := Find(ctx, l.paginator(&testInput{}), testItems, func(v string) bool {
+			return strings.HasPrefix(v, "al")
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if diff := cmp.Diff(got, []string{"alpha", "alpine"}); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		t.Parallel()
+
+		l := newTestLister()
+
+		got, err := Find(ctx, l.paginator(&testInput{}), testItems, func(v string) bool {
+			return false
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, want := len(got), 0; got != want {
+			t.Errorf("length of items = %v, want %v", got, want)
+		}
+		if got, want := len(l.calls), 3; got != want {
+			t.Errorf("length of calls = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		l := newTestLister()
+		l.err = errors.New("test error")
+
+		_, err := Find(ctx, l.paginator(&testInput{}), testItems, func(v string) bool {
+			return true
+		})
+
+		if !errors.Is(err, l.err) {
+			t.Errorf("err = %v, want %v", err, l.err)
+		}
+	})
+}
+
+func TestFindFirst(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("early exit", func(t *testing.T) {
+		t.Parallel()
+
+		l := newTestLister()
+
+		got, err := FindFirst(ctx, l.paginator(&testInput{}), testItems, func(v string) bool {
+			return strings.HasPrefix(v, "b")
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, want := *got, "bravo"; got != want {
+			t.Errorf("item = %v, want %v", got, want)
+		}
+		if diff := cmp.Diff(l.calls, []string{""}); diff != "" {
+			t.Errorf("unexpected calls diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		t.Parallel()
+
+		l := newTestLister()
+
+		got, err := FindFirst(ctx, l.paginator(&testInput{}), testItems, func(v string) bool {
+			return strings.HasPrefix(v, "c")
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, want := *got, "charlie"; got != want {
+			t.Errorf("item = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		l := newTestLister()
+
+		_, err := FindFirst(ctx, l.paginator(&testInput{}), testItems, func(v string) bool {
+			return false
+		})
+
+		if !tfresource.NotFound(err) {
+			t.Errorf("err = %v, want not found", err)
+		}
+	})
+}
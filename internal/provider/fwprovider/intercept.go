@@ -3,16 +3,28 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/audit"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -91,11 +103,16 @@ func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDRespon
 		forward := interceptors
 
 		when := Before
-		for _, v := range forward {
+		for i, v := range forward {
 			ctx, diags = v(ctx, request, response, meta, when, diags)
 
 			// Short circuit if any Before interceptor errors.
+			// Finally interceptors are still run for the interceptors that have run, e.g. so that the operation is audited.
 			if diags.HasError() {
+				for _, v := range slices.Reverse(forward[:i+1]) {
+					ctx, diags = v(ctx, request, response, meta, Finally, diags)
+				}
+
 				return diags
 			}
 		}
@@ -131,12 +148,14 @@ type wrappedDataSource struct {
 	bootstrapContext contextFunc
 	inner            datasource.DataSourceWithConfigure
 	meta             *conns.AWSClient
+	regional         bool // Supports the `region` meta-argument?
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, regional bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
+		regional:         regional,
 	}
 }
 
@@ -148,18 +167,78 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regional && response.Schema.Attributes != nil {
+		response.Schema.Attributes[names.AttrRegion] = dschema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				fwvalidators.RegionName(),
+			},
+			Description: "AWS Region to read from. Defaults to the provider's Region.",
+		}
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if !w.regional {
+		w.inner.Read(ctx, request, response)
+
+		return
+	}
+
+	var region fwtypes.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.Region = region.ValueString()
+	}
+
+	response.Diagnostics.Append(w.configureRegion(ctx)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	w.inner.Read(ctx, request, response)
+
+	if response.Diagnostics.HasError() || response.State.Raw.IsNull() || w.meta == nil {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), w.meta.RegionalClient(region.ValueString()).Region)...)
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Configure(ctx, request, response)
 }
 
+// configureRegion configures the inner data source with the client for any Region override in Context.
+func (w *wrappedDataSource) configureRegion(ctx context.Context) diag.Diagnostics {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok || w.meta == nil {
+		return nil
+	}
+
+	client := w.meta.RegionalClient(inContext.Region)
+	if client == w.meta {
+		return nil
+	}
+
+	var response datasource.ConfigureResponse
+	w.inner.Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, &response)
+
+	return response.Diagnostics
+}
+
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
@@ -167,13 +246,15 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	regional         bool // Supports the `region` meta-argument?
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, regional bool) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		regional:         regional,
 	}
 }
 
@@ -185,10 +266,21 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regional && response.Schema.Attributes != nil {
+		response.Schema.Attributes[names.AttrRegion] = regionResourceAttribute(func() *conns.AWSClient {
+			return w.meta
+		})
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+		response.Diagnostics.Append(w.configureRegion(ctx)...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
 		w.inner.Create(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -199,6 +291,11 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+		response.Diagnostics.Append(w.configureRegion(ctx)...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -209,6 +306,11 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+		response.Diagnostics.Append(w.configureRegion(ctx)...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
 		w.inner.Update(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -219,6 +321,11 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		response.Diagnostics.Append(w.configureRegion(ctx)...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
 		w.inner.Delete(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -235,6 +342,24 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 	w.inner.Configure(ctx, request, response)
 }
 
+// configureRegion configures the inner resource with the client for any Region override in Context.
+func (w *wrappedResource) configureRegion(ctx context.Context) diag.Diagnostics {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok || w.meta == nil {
+		return nil
+	}
+
+	client := w.meta.RegionalClient(inContext.Region)
+	if client == w.meta {
+		return nil
+	}
+
+	var response resource.ConfigureResponse
+	w.inner.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &response)
+
+	return response.Diagnostics
+}
+
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
@@ -315,6 +440,8 @@ func (r tagsInterceptor) create(ctx context.Context, request resource.CreateRequ
 
 		// Merge the resource's configured tags with any provider configured default_tags.
 		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+		// Apply any provider configured normalize_tags.
+		tags = tags.Normalize(tagsInContext.NormalizeConfig)
 		// Remove system tags.
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
@@ -387,6 +514,7 @@ func (r tagsInterceptor) read(ctx context.Context, request resource.ReadRequest,
 				if identifier != "" {
 					// If the service package has a generic resource list tags methods, call it.
 					var err error
+					sp := meta.TagsServicePackage(sp, identifier)
 
 					if v, ok := sp.(interface {
 						ListTags(context.Context, any, string) error
@@ -414,11 +542,19 @@ func (r tagsInterceptor) read(ctx context.Context, request resource.ReadRequest,
 
 		apiTags := tagsInContext.TagsOut.UnwrapOrDefault()
 
+		var priorTags fwtypes.Map
+		diags.Append(response.State.GetAttribute(ctx, path.Root(names.AttrTags), &priorTags)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
 		// AWS APIs often return empty lists of tags when none have been configured.
 		stateTags := tftags.Null
 		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
 		// The resource's configured tags do not include any provider configured default_tags.
-		if v := apiTags.IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).ResolveDuplicatesFramework(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, response, diags).Map(); len(v) > 0 {
+		// Tags normalized by any provider configured normalize_tags are kept as configured.
+		if v := apiTags.IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).ResolveDuplicatesFramework(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, response, diags).Denormalize(tagsInContext.NormalizeConfig, tftags.New(ctx, priorTags)).Map(); len(v) > 0 {
 			stateTags = flex.FlattenFrameworkStringValueMapLegacy(ctx, v)
 		}
 		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTags), &stateTags)...)
@@ -480,6 +616,8 @@ func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequ
 
 		// Merge the resource's configured tags with any provider configured default_tags.
 		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+		// Apply any provider configured normalize_tags.
+		tags = tags.Normalize(tagsInContext.NormalizeConfig)
 		// Remove system tags.
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
@@ -514,6 +652,7 @@ func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequ
 				if identifier != "" {
 					// If the service package has a generic resource update tags methods, call it.
 					var err error
+					sp := meta.TagsServicePackage(sp, identifier)
 
 					if v, ok := sp.(interface {
 						UpdateTags(context.Context, any, string, any, any) error
@@ -547,3 +686,375 @@ func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequ
 func (r tagsInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// auditInterceptor writes an audit log record for each CRUD operation.
+type auditInterceptor struct{}
+
+func (r auditInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, audit.OperationCreate, response.State, when, diags)
+}
+
+func (r auditInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, audit.OperationRead, response.State, when, diags)
+}
+
+func (r auditInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, audit.OperationUpdate, response.State, when, diags)
+}
+
+func (r auditInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, audit.OperationDelete, request.State, when, diags)
+}
+
+func (r auditInterceptor) run(ctx context.Context, meta *conns.AWSClient, operation string, state tfsdk.State, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil || meta.AuditLogger == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		ctx = audit.NewContext(ctx)
+	case Finally:
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return ctx, diags
+		}
+
+		auditInContext, ok := audit.FromContext(ctx)
+		if !ok {
+			return ctx, diags
+		}
+
+		// Not all resources have an "id" attribute and the state may not have been set.
+		var id fwtypes.String
+		if !state.Raw.IsNull() {
+			state.GetAttribute(ctx, path.Root("id"), &id)
+		}
+
+		apiCalls := auditInContext.APICalls()
+		record := audit.Record{
+			Time:           auditInContext.Start,
+			AccountID:      meta.AccountID,
+			Region:         meta.Region,
+			ResourceType:   inContext.TypeName,
+			Operation:      operation,
+			ID:             id.ValueString(),
+			DurationMillis: time.Since(auditInContext.Start).Milliseconds(),
+			APICalls:       apiCalls,
+		}
+
+		if diags.HasError() {
+			record.ErrorCode = audit.ErrorCode(apiCalls)
+			record.Error = fwdiag.DiagnosticsError(diags).Error()
+		}
+
+		if err := meta.AuditLogger.Write(record); err != nil {
+			diags.AddWarning("writing audit log", err.Error())
+		}
+	}
+
+	return ctx, diags
+}
+
+// readOnlyInterceptor prevents resources being created, updated or deleted when the provider is configured as read-only.
+type readOnlyInterceptor struct{}
+
+func (r readOnlyInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, "create", tfsdk.State{}, when, diags)
+}
+
+func (r readOnlyInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r readOnlyInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, "update", request.State, when, diags)
+}
+
+func (r readOnlyInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, "delete", request.State, when, diags)
+}
+
+func (r readOnlyInterceptor) run(ctx context.Context, meta *conns.AWSClient, operation string, state tfsdk.State, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil || !meta.ReadOnly {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		resource := "resource"
+		if inContext, ok := conns.FromContext(ctx); ok {
+			resource = inContext.TypeName
+		}
+
+		// Not all resources have an "id" attribute.
+		var id fwtypes.String
+		if !state.Raw.IsNull() {
+			state.GetAttribute(ctx, path.Root("id"), &id)
+		}
+		if v := id.ValueString(); v != "" {
+			resource = fmt.Sprintf("%s (%s)", resource, v)
+		}
+
+		diags.AddError(
+			"Provider is read-only",
+			fmt.Sprintf("Cannot %s %s: the provider is configured with `read_only = true` and resources cannot be created, updated or deleted.\n\n"+
+				"Remove `read_only` from the provider configuration to allow changes.", operation, resource),
+		)
+	}
+
+	return ctx, diags
+}
+
+type semaphoreReleaseContextKeyType int
+
+var (
+	semaphoreReleaseContextKey semaphoreReleaseContextKeyType
+)
+
+// defaultConcurrencyTimeout is the longest that an operation waits for a concurrency limit if the resource has no timeout
+// for the operation. It is the Plugin SDK's default operation timeout.
+const defaultConcurrencyTimeout = 20 * time.Minute
+
+// resourceWithTimeouts is implemented by resources that embed framework.WithTimeouts.
+type resourceWithTimeouts interface {
+	CreateTimeout(context.Context, timeouts.Value) time.Duration
+	UpdateTimeout(context.Context, timeouts.Value) time.Duration
+	DeleteTimeout(context.Context, timeouts.Value) time.Duration
+}
+
+// concurrencyInterceptor limits the number of concurrent Create, Update and Delete operations.
+type concurrencyInterceptor struct {
+	semaphore *tfsync.Semaphore
+	timeouts  resourceWithTimeouts // The resource's operation timeouts, or nil.
+}
+
+func (r concurrencyInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	var timeout time.Duration
+	if r.timeouts != nil && when == Before {
+		timeout = r.timeouts.CreateTimeout(ctx, configuredTimeouts(ctx, request.Plan.GetAttribute))
+	}
+
+	return r.run(ctx, tfsdk.State{}, timeout, when, diags)
+}
+
+func (r concurrencyInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r concurrencyInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	var timeout time.Duration
+	if r.timeouts != nil && when == Before {
+		timeout = r.timeouts.UpdateTimeout(ctx, configuredTimeouts(ctx, request.Plan.GetAttribute))
+	}
+
+	return r.run(ctx, request.State, timeout, when, diags)
+}
+
+func (r concurrencyInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	var timeout time.Duration
+	if r.timeouts != nil && when == Before {
+		timeout = r.timeouts.DeleteTimeout(ctx, configuredTimeouts(ctx, request.State.GetAttribute))
+	}
+
+	return r.run(ctx, request.State, timeout, when, diags)
+}
+
+func (r concurrencyInterceptor) run(ctx context.Context, state tfsdk.State, timeout time.Duration, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		holderName := "<resource>"
+		if inContext, ok := conns.FromContext(ctx); ok {
+			holderName = inContext.TypeName
+		}
+
+		// Not all resources have an "id" attribute.
+		var id fwtypes.String
+		if !state.Raw.IsNull() {
+			state.GetAttribute(ctx, path.Root("id"), &id)
+		}
+		if v := id.ValueString(); v != "" {
+			holderName = fmt.Sprintf("%s (%s)", holderName, v)
+		}
+
+		// Wait no longer than the operation's timeout.
+		if timeout <= 0 {
+			timeout = defaultConcurrencyTimeout
+		}
+		acquireCtx, cancel := context.WithTimeout(tfsync.WithHolderName(ctx, holderName), timeout)
+		defer cancel()
+
+		release, err := r.semaphore.Acquire(acquireCtx, 1)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("acquiring concurrency limit for %s", holderName), err.Error())
+
+			return ctx, diags
+		}
+
+		ctx = context.WithValue(ctx, semaphoreReleaseContextKey, release)
+	case Finally:
+		if release, ok := ctx.Value(semaphoreReleaseContextKey).(func()); ok {
+			release()
+		}
+	}
+
+	return ctx, diags
+}
+
+// configuredTimeouts returns the value of a resource's "timeouts" block.
+// The value is null if the resource has no "timeouts" block.
+func configuredTimeouts(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics) timeouts.Value {
+	var v timeouts.Value
+
+	getAttribute(ctx, path.Root("timeouts"), &v)
+
+	return v
+}
+
+// regionInterceptor implements the `region` meta-argument.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.Plan.GetAttribute, diags)
+	case After:
+		return ctx, r.after(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.State.GetAttribute, diags)
+	case After:
+		// Read may have removed the resource from state.
+		if !response.State.Raw.IsNull() {
+			return ctx, r.after(ctx, &response.State, meta, diags)
+		}
+	}
+
+	return ctx, diags
+}
+
+func (r regionInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.Plan.GetAttribute, diags)
+	case After:
+		return ctx, r.after(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		return r.before(ctx, request.State.GetAttribute, diags)
+	}
+
+	return ctx, diags
+}
+
+// before sets any Region override in Context.
+func (r regionInterceptor) before(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	var region fwtypes.String
+	diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	inContext.Region = region.ValueString()
+
+	return ctx, diags
+}
+
+// after sets the Region that the resource is in.
+func (r regionInterceptor) after(ctx context.Context, state *tfsdk.State, meta *conns.AWSClient, diags diag.Diagnostics) diag.Diagnostics {
+	if meta == nil {
+		return diags
+	}
+
+	region := meta.Region
+	if inContext, ok := conns.FromContext(ctx); ok && inContext.Region != "" {
+		region = inContext.Region
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+
+	return diags
+}
+
+// regionPlanModifier plans the provider's Region for a new resource if the `region` meta-argument is not configured.
+// An existing resource stays in the Region that it is in.
+type regionPlanModifier struct {
+	meta func() *conns.AWSClient
+}
+
+func (m regionPlanModifier) Description(_ context.Context) string {
+	return "Defaults to the provider's Region."
+}
+
+func (m regionPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m regionPlanModifier) PlanModifyString(ctx context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
+	if !request.ConfigValue.IsNull() {
+		return
+	}
+
+	if !request.State.Raw.IsNull() {
+		// Don't force replacement of resources whose state predates the meta-argument.
+		if !request.StateValue.IsNull() {
+			response.PlanValue = request.StateValue
+		}
+
+		return
+	}
+
+	if meta := m.meta(); meta != nil {
+		response.PlanValue = fwtypes.StringValue(meta.Region)
+	}
+}
+
+// regionResourceAttribute returns the schema of the `region` meta-argument.
+// Only changing a configured Region forces replacement, not changing the provider's Region.
+func regionResourceAttribute(meta func() *conns.AWSClient) rschema.StringAttribute {
+	return rschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			regionPlanModifier{meta: meta},
+			stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, request planmodifier.StringRequest, response *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+				if request.ConfigValue.IsNull() {
+					return
+				}
+
+				// A resource whose state predates the meta-argument is in the provider's Region.
+				if request.StateValue.IsNull() {
+					if meta := meta(); meta != nil {
+						response.RequiresReplace = request.ConfigValue.ValueString() != meta.Region
+					}
+
+					return
+				}
+
+				response.RequiresReplace = true
+			}, "Changing the configured Region forces replacement.", "Changing the configured Region forces replacement."),
+		},
+		Validators: []validator.String{
+			fwvalidators.RegionName(),
+		},
+		Description: "AWS Region that the resource is in. Defaults to the provider's Region.",
+	}
+}
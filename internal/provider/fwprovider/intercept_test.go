@@ -0,0 +1,290 @@
+package fwprovider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type testDataSource struct {
+	meta *conns.AWSClient
+}
+
+func (d *testDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (d *testDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *testDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		d.meta = v
+	}
+}
+
+func (d *testDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	response.State.Raw = request.Config.Raw
+}
+
+func TestWrappedDataSourceReadRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &conns.AWSClient{
+		AccountID: "123456789012",
+		DNSSuffix: "amazonaws.com",
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+	bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+		return conns.NewDataSourceContext(ctx, "test", "Test", "aws_test")
+	}
+
+	testCases := map[string]struct {
+		region   tftypes.Value
+		expected string
+	}{
+		"default": {
+			region:   tftypes.NewValue(tftypes.String, nil),
+			expected: "us-west-2",
+		},
+		"configured": {
+			region:   tftypes.NewValue(tftypes.String, "eu-west-1"),
+			expected: "eu-west-1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			inner := &testDataSource{}
+			ds := newWrappedDataSource(bootstrapContext, inner, true)
+
+			ds.Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, &datasource.ConfigureResponse{})
+
+			var schemaResponse datasource.SchemaResponse
+			ds.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+			raw := tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				names.AttrName:   tftypes.NewValue(tftypes.String, "test"),
+				names.AttrRegion: testCase.region,
+			})
+			request := datasource.ReadRequest{
+				Config: tfsdk.Config{Raw: raw, Schema: schemaResponse.Schema},
+			}
+			response := datasource.ReadResponse{
+				State: tfsdk.State{Raw: raw, Schema: schemaResponse.Schema},
+			}
+
+			ds.Read(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			var region types.String
+			if diags := response.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := region.ValueString(), testCase.expected; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+			if got, want := inner.meta.Region, testCase.expected; got != want {
+				t.Errorf("data source client Region = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestRegionResourceAttributePlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &conns.AWSClient{
+		Region: "us-west-2",
+	}
+	attribute := regionResourceAttribute(func() *conns.AWSClient {
+		return client
+	})
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{names.AttrRegion: tftypes.String}}
+	object := func(region any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			names.AttrRegion: tftypes.NewValue(tftypes.String, region),
+		})
+	}
+
+	testCases := map[string]struct {
+		config          types.String
+		state           types.String
+		stateRaw        tftypes.Value
+		expectedPlan    types.String
+		expectedReplace bool
+	}{
+		"create not configured": {
+			config:       types.StringNull(),
+			state:        types.StringNull(),
+			stateRaw:     tftypes.NewValue(objectType, nil),
+			expectedPlan: types.StringValue("us-west-2"),
+		},
+		"create configured": {
+			config:       types.StringValue("eu-west-1"),
+			state:        types.StringNull(),
+			stateRaw:     tftypes.NewValue(objectType, nil),
+			expectedPlan: types.StringValue("eu-west-1"),
+		},
+		"not configured": {
+			config:       types.StringNull(),
+			state:        types.StringValue("us-east-1"),
+			stateRaw:     object("us-east-1"),
+			expectedPlan: types.StringValue("us-east-1"),
+		},
+		"configured unchanged": {
+			config:       types.StringValue("us-east-1"),
+			state:        types.StringValue("us-east-1"),
+			stateRaw:     object("us-east-1"),
+			expectedPlan: types.StringValue("us-east-1"),
+		},
+		"configured changed": {
+			config:          types.StringValue("eu-west-1"),
+			state:           types.StringValue("us-east-1"),
+			stateRaw:        object("us-east-1"),
+			expectedPlan:    types.StringValue("eu-west-1"),
+			expectedReplace: true,
+		},
+		"state predates meta-argument": {
+			config:       types.StringValue("us-west-2"),
+			state:        types.StringNull(),
+			stateRaw:     object(nil),
+			expectedPlan: types.StringValue("us-west-2"),
+		},
+		"state predates meta-argument configured changed": {
+			config:          types.StringValue("eu-west-1"),
+			state:           types.StringNull(),
+			stateRaw:        object(nil),
+			expectedPlan:    types.StringValue("eu-west-1"),
+			expectedReplace: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := testCase.config
+			if plan.IsNull() {
+				plan = types.StringUnknown()
+			}
+			request := planmodifier.StringRequest{
+				Path:        path.Root(names.AttrRegion),
+				ConfigValue: testCase.config,
+				Plan:        tfsdk.Plan{Raw: object(tftypes.UnknownValue)},
+				PlanValue:   plan,
+				State:       tfsdk.State{Raw: testCase.stateRaw},
+				StateValue:  testCase.state,
+			}
+			response := planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			for _, v := range attribute.PlanModifiers {
+				v.PlanModifyString(ctx, request, &response)
+				request.PlanValue = response.PlanValue
+			}
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			if got, want := response.PlanValue, testCase.expectedPlan; !got.Equal(want) {
+				t.Errorf("plan = %s, want %s", got, want)
+			}
+			if got, want := response.RequiresReplace, testCase.expectedReplace; got != want {
+				t.Errorf("RequiresReplace = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestConcurrencyInterceptorTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	semaphore := tfsync.NewSemaphore("test", 1)
+
+	release, err := semaphore.Acquire(ctx, 1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer release()
+
+	var resourceTimeouts framework.WithTimeouts
+	resourceTimeouts.SetDefaultDeleteTimeout(time.Hour)
+
+	interceptor := concurrencyInterceptor{
+		semaphore: semaphore,
+		timeouts:  &resourceTimeouts,
+	}
+
+	s := rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			names.AttrID: rschema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]rschema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Delete: true,
+			}),
+		},
+	}
+	typ := s.Type().TerraformType(ctx).(tftypes.Object)
+	state := tfsdk.State{
+		Raw: tftypes.NewValue(typ, map[string]tftypes.Value{
+			names.AttrID: tftypes.NewValue(tftypes.String, "test"),
+			"timeouts": tftypes.NewValue(typ.AttributeTypes["timeouts"], map[string]tftypes.Value{
+				"delete": tftypes.NewValue(tftypes.String, "10ms"),
+			}),
+		}),
+		Schema: s,
+	}
+
+	start := time.Now()
+	_, diags := interceptor.delete(ctx, resource.DeleteRequest{State: state}, &resource.DeleteResponse{}, nil, Before, nil)
+
+	if !diags.HasError() {
+		t.Fatal("expected error")
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Minute {
+		t.Errorf("acquire took %s, want no longer than the configured Delete timeout", elapsed)
+	}
+}
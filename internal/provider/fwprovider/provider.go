@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -48,6 +49,18 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_recording_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "File that AWS API interactions are recorded to or replayed from. Can also be configured using the `TF_AWS_API_RECORDING_FILE` environment variable.",
+			},
+			"api_recording_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "Whether AWS API interactions are recorded to (`record`) or replayed from (`replay`) the API recording file. Can also be configured using the `TF_AWS_API_RECORDING_MODE` environment variable.",
+			},
+			"audit_log_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "File to which a JSON Lines audit record of each resource operation, including the AWS API operations invoked, is appended.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -80,10 +93,18 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Prevent the provider from creating, updating or deleting any resources.\nResources can still be read and data sources can still be used.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"resource_groups_tagging_api": schema.StringAttribute{
+				Optional:    true,
+				Description: "When resources identified by ARN are tagged using the Resource Groups Tagging API. Valid values are `fallback` (only resources whose service has no generic tagging support) and `always`.",
+			},
 			"retry_mode": schema.StringAttribute{
 				Optional:    true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
@@ -140,6 +161,57 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"account_guard": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to restrict the AWS accounts that the provider can be used with.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allowed_account_ids": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "AWS account IDs that are allowed.",
+						},
+						"allowed_organization_ids": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "AWS Organizations organization IDs that are allowed.",
+						},
+						"allowed_organizational_unit_paths": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "AWS Organizations organizational unit paths, e.g. `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`, that are allowed. The account's path must start with one of the paths.",
+						},
+						"allowed_partitions": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "AWS partitions that are allowed.",
+						},
+						"forbidden_account_ids": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "AWS account IDs that are forbidden.",
+						},
+						"forbidden_organization_ids": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "AWS Organizations organization IDs that are forbidden.",
+						},
+						"forbidden_organizational_unit_paths": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "AWS Organizations organizational unit paths, e.g. `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`, that are forbidden. The account is forbidden if its path starts with one of the paths.",
+						},
+						"forbidden_partitions": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "AWS partitions that are forbidden.",
+						},
+					},
+				},
+			},
+			"api_rate_limits": apiRateLimitsBlock(),
 			"assume_role": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -227,12 +299,29 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				},
 			},
 			"default_tags": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
-				Description: "Configuration block with settings to default resource tags across all resources.",
+				Description: "Configuration blocks with settings to default resource tags across all resources or those matching a selector.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types that the tags are not defaulted on.",
+						},
+						"exclude_services": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Service names that the tags are not defaulted on.",
+						},
+						"include_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types that the tags are defaulted on.",
+						},
+						"include_services": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Service names that the tags are defaulted on.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -262,6 +351,49 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"normalize_tags": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to normalize resource tag keys and values across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key_case": schema.StringAttribute{
+							Optional:    true,
+							Description: "Case that resource tag keys are converted to. Valid values are `lower` and `upper`.",
+						},
+						"trim_whitespace": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether leading and trailing whitespace is removed from resource tag keys and values.",
+						},
+						"truncate": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether resource tag keys and values are truncated to 128 and 256 characters respectively.",
+						},
+					},
+				},
+			},
+			"required_tags": schema.ListNestedBlock{
+				Description: "Configuration blocks with tags that taggable resources must have.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource tag key that is required.",
+						},
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types that the tag is required on. Defaults to all taggable resources.",
+						},
+						"values": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching allowed tag values. Defaults to any value.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -300,18 +432,31 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				continue
 			}
 
+			metadataResponse := datasource.MetadataResponse{}
+			inner.Metadata(ctx, datasource.MetadataRequest{}, &metadataResponse)
+			typeName := metadataResponse.TypeName
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfigForContext(ctx), meta.IgnoreTagsConfig, meta.NormalizeTagsConfig)
 				}
 
 				return ctx
 			}
 
+			// Data sources of regional services that don't define their own `region` attribute support the `region` meta-argument.
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+			_, ok := schemaResponse.Schema.Attributes[names.AttrRegion]
+			regional := !ok && !conns.IsGlobalService(servicePackageName)
+
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner)
+				// Each request gets its own data source, which is configured with the client for any Region override.
+				inner, _ := v.Factory(ctx)
+
+				return newWrappedDataSource(bootstrapContext, inner, regional)
 			})
 		}
 	}
@@ -346,21 +491,32 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfigForContext(ctx), meta.IgnoreTagsConfig, meta.NormalizeTagsConfig)
 				}
 
 				return ctx
 			}
 			interceptors := resourceInterceptors{}
 
+			// Resources of regional services that don't define their own `region` attribute support the `region` meta-argument.
+			// The Region override must be in Context before any other interceptors run.
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+			_, ok := schemaResponse.Schema.Attributes[names.AttrRegion]
+			regional := !ok && !conns.IsGlobalService(servicePackageName)
+
+			if regional {
+				interceptors = append(interceptors, regionInterceptor{})
+			}
+
+			// Operations refused by the read-only interceptor are audited.
+			interceptors = append(interceptors, auditInterceptor{}, readOnlyInterceptor{})
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = multierror.Append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -383,8 +539,28 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsInterceptor{tags: v.Tags})
 			}
 
+			// Concurrency limits are acquired last so that they are not held while any other Before interceptors run.
+			if v := v.Concurrency; v != nil {
+				key := v.Key
+				if key == "" {
+					key = typeName
+				}
+
+				interceptor := concurrencyInterceptor{
+					semaphore: tfsync.GlobalSemaphoreKV.Get(key, v.Limit),
+				}
+				if timeouts, ok := inner.(resourceWithTimeouts); ok {
+					interceptor.timeouts = timeouts
+				}
+
+				interceptors = append(interceptors, interceptor)
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				// Each request gets its own resource, which is configured with the client for any Region override.
+				inner, _ := v.Factory(ctx)
+
+				return newWrappedResource(bootstrapContext, inner, interceptors, regional)
 			})
 		}
 	}
@@ -398,6 +574,26 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+func apiRateLimitsBlock() schema.ListNestedBlock {
+	apiRateLimitsAttributes := make(map[string]schema.Attribute)
+
+	for _, serviceKey := range names.Aliases() {
+		apiRateLimitsAttributes[serviceKey] = schema.Float64Attribute{
+			Optional:    true,
+			Description: "Use this to override the default maximum number of AWS API requests per second",
+		}
+	}
+
+	return schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: apiRateLimitsAttributes,
+		},
+	}
+}
+
 func endpointsBlock() schema.SetNestedBlock {
 	endpointsAttributes := make(map[string]schema.Attribute)
 
@@ -1,4 +1,5 @@
 //go:generate go run ../generate/servicepackages/main.go
+//go:generate go run ../generate/schematests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package provider
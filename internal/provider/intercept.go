@@ -2,18 +2,23 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/audit"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -27,6 +32,7 @@ type schemaResourceData interface {
 	HasChange(key string) bool
 	Id() string
 	Set(string, any) error
+	Timeout(string) time.Duration
 }
 
 // An interceptor is functionality invoked during the CRUD request lifecycle.
@@ -92,17 +98,31 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 		forward := interceptors.why(why)
 
 		when := Before
-		for _, v := range forward {
+		for i, v := range forward {
 			if v.when&when != 0 {
 				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
 
 				// Short circuit if any Before interceptor errors.
+				// Finally interceptors are still run for the interceptors that have run, e.g. so that the operation is audited.
 				if diags.HasError() {
+					for _, v := range slices.Reverse(forward[:i+1]) {
+						if v.when&Finally != 0 {
+							ctx, diags = v.interceptor.run(ctx, d, meta, Finally, why, diags)
+						}
+					}
+
 					return diags
 				}
 			}
 		}
 
+		// Use the client for any Region override.
+		if v, ok := meta.(*conns.AWSClient); ok {
+			if inContext, ok := conns.FromContext(ctx); ok {
+				meta = v.RegionalClient(inContext.Region)
+			}
+		}
+
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
 		diags = f(ctx, d, meta)
@@ -235,6 +255,8 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 		case Create, Update:
 			// Merge the resource's configured tags with any provider configured default_tags.
 			tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
+			// Apply any provider configured normalize_tags.
+			tags = tags.Normalize(tagsInContext.NormalizeConfig)
 			// Remove system tags.
 			tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
@@ -261,6 +283,7 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 
 							// If the service package has a generic resource update tags methods, call it.
 							var err error
+							sp := meta.(*conns.AWSClient).TagsServicePackage(sp, identifier)
 
 							if v, ok := sp.(interface {
 								UpdateTags(context.Context, any, string, any, any) error
@@ -313,6 +336,7 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 					if identifier != "" {
 						// If the service package has a generic resource list tags methods, call it.
 						var err error
+						sp := meta.(*conns.AWSClient).TagsServicePackage(sp, identifier)
 
 						if v, ok := sp.(interface {
 							ListTags(context.Context, any, string) error
@@ -347,7 +371,9 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
 
 			// The resource's configured tags can now include duplicate tags that have been configured on the provider.
-			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d).Map()); err != nil {
+			// Tags normalized by any provider configured normalize_tags are kept as configured.
+			configTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d).Denormalize(tagsInContext.NormalizeConfig, configTags).Map()); err != nil {
 				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
 			}
 
@@ -368,3 +394,221 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 
 	return ctx, diags
 }
+
+// auditInterceptor writes an audit log record for each CRUD operation.
+type auditInterceptor struct{}
+
+func (r auditInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	v, ok := meta.(*conns.AWSClient)
+	if !ok || v.AuditLogger == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		ctx = audit.NewContext(ctx)
+	case Finally:
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return ctx, diags
+		}
+
+		auditInContext, ok := audit.FromContext(ctx)
+		if !ok {
+			return ctx, diags
+		}
+
+		var operation string
+		switch why {
+		case Create:
+			operation = audit.OperationCreate
+		case Read:
+			operation = audit.OperationRead
+		case Update:
+			operation = audit.OperationUpdate
+		case Delete:
+			operation = audit.OperationDelete
+		}
+
+		apiCalls := auditInContext.APICalls()
+		record := audit.Record{
+			Time:           auditInContext.Start,
+			AccountID:      v.AccountID,
+			Region:         v.Region,
+			ResourceType:   inContext.TypeName,
+			Operation:      operation,
+			ID:             d.Id(),
+			DurationMillis: time.Since(auditInContext.Start).Milliseconds(),
+			APICalls:       apiCalls,
+		}
+
+		if diags.HasError() {
+			record.ErrorCode = audit.ErrorCode(apiCalls)
+			record.Error = sdkdiag.DiagnosticsError(diags).Error()
+		}
+
+		if err := v.AuditLogger.Write(record); err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "writing audit log: %s", err)
+		}
+	}
+
+	return ctx, diags
+}
+
+// readOnlyInterceptor prevents resources being created, updated or deleted when the provider is configured as read-only.
+type readOnlyInterceptor struct{}
+
+func (r readOnlyInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if v, ok := meta.(*conns.AWSClient); !ok || !v.ReadOnly {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		var operation string
+		switch why {
+		case Create:
+			operation = "create"
+		case Update:
+			operation = "update"
+		case Delete:
+			operation = "delete"
+		default:
+			return ctx, diags
+		}
+
+		var typeName string
+		if inContext, ok := conns.FromContext(ctx); ok {
+			typeName = inContext.TypeName
+		}
+
+		return ctx, append(diags, readOnlyDiag(operation, typeName, d.Id()))
+	}
+
+	return ctx, diags
+}
+
+func readOnlyDiag(operation, typeName, id string) diag.Diagnostic {
+	resource := typeName
+	if id != "" {
+		resource = fmt.Sprintf("%s (%s)", typeName, id)
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Provider is read-only",
+		Detail: fmt.Sprintf("Cannot %s %s: the provider is configured with `read_only = true` and resources cannot be created, updated or deleted.\n\n"+
+			"Remove `read_only` from the provider configuration to allow changes.", operation, resource),
+	}
+}
+
+type semaphoreReleaseContextKeyType int
+
+var (
+	semaphoreReleaseContextKey semaphoreReleaseContextKeyType
+)
+
+// concurrencyInterceptor limits the number of concurrent Create, Update and Delete operations.
+type concurrencyInterceptor struct {
+	semaphore *tfsync.Semaphore
+}
+
+func (r concurrencyInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var timeoutKey string
+		switch why {
+		case Create:
+			timeoutKey = schema.TimeoutCreate
+		case Update:
+			timeoutKey = schema.TimeoutUpdate
+		case Delete:
+			timeoutKey = schema.TimeoutDelete
+		default:
+			return ctx, diags
+		}
+
+		holderName := "<resource>"
+		if inContext, ok := conns.FromContext(ctx); ok {
+			holderName = inContext.TypeName
+		}
+		if id := d.Id(); id != "" {
+			holderName = fmt.Sprintf("%s (%s)", holderName, id)
+		}
+
+		// Wait no longer than the operation's timeout.
+		acquireCtx, cancel := context.WithTimeout(tfsync.WithHolderName(ctx, holderName), d.Timeout(timeoutKey))
+		defer cancel()
+
+		release, err := r.semaphore.Acquire(acquireCtx, 1)
+
+		if err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "acquiring concurrency limit for %s: %s", holderName, err)
+		}
+
+		ctx = context.WithValue(ctx, semaphoreReleaseContextKey, release)
+	case Finally:
+		if release, ok := ctx.Value(semaphoreReleaseContextKey).(func()); ok {
+			release()
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionInterceptor implements the `region` meta-argument.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		if v, ok := d.Get(names.AttrRegion).(string); ok {
+			inContext.Region = v
+		}
+	case After:
+		// Set the Region that the resource is in.
+		if why != Delete && d.Id() != "" {
+			if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).Region); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionCustomizeDiff plans the provider's Region for a new resource if the `region` meta-argument is not configured.
+// An existing resource stays in the Region that it is in: only changing a configured Region forces replacement.
+func regionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	client, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return nil
+	}
+
+	if d.Id() != "" {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.GetAttr(names.AttrRegion).IsNull() {
+		return nil
+	}
+
+	return d.SetNew(names.AttrRegion, client.Region)
+}
+
+// regionSchema returns the schema of the `region` meta-argument.
+func regionSchema(isDataSource bool) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     !isDataSource,
+		ValidateFunc: verify.ValidRegionName,
+	}
+}
@@ -2,11 +2,18 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/audit"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 )
 
 func TestInterceptorsWhy(t *testing.T) {
@@ -90,3 +97,211 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestReadOnlyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptors := interceptorItems{
+		{
+			when:        Before,
+			why:         Create | Update | Delete,
+			interceptor: readOnlyInterceptor{},
+		},
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return conns.NewResourceContext(ctx, "test", "Test", "aws_test")
+	}
+
+	testCases := []struct {
+		name          string
+		readOnly      bool
+		why           why
+		expectCalled  bool
+		expectedDiags int
+	}{
+		{
+			name:         "create",
+			why:          Create,
+			expectCalled: true,
+		},
+		{
+			name:          "read-only create",
+			readOnly:      true,
+			why:           Create,
+			expectedDiags: 1,
+		},
+		{
+			name:         "read-only read",
+			readOnly:     true,
+			why:          Read,
+			expectCalled: true,
+		},
+		{
+			name:          "read-only update",
+			readOnly:      true,
+			why:           Update,
+			expectedDiags: 1,
+		},
+		{
+			name:          "read-only delete",
+			readOnly:      true,
+			why:           Delete,
+			expectedDiags: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var called bool
+			var f schema.CreateContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				called = true
+				return nil
+			}
+			meta := &conns.AWSClient{ReadOnly: testCase.readOnly}
+
+			diags := interceptedHandler(bootstrapContext, interceptors, f, testCase.why)(context.Background(), &schema.ResourceData{}, meta)
+
+			if got, want := called, testCase.expectCalled; got != want {
+				t.Errorf("handler called = %v, want %v", got, want)
+			}
+			if got, want := len(diags), testCase.expectedDiags; got != want {
+				t.Errorf("length of diags = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestReadOnlyInterceptorAudited(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	logger, err := audit.NewLogger(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	interceptors := interceptorItems{
+		{
+			when:        Before | Finally,
+			why:         AllOps,
+			interceptor: auditInterceptor{},
+		},
+		{
+			when:        Before,
+			why:         Create | Update | Delete,
+			interceptor: readOnlyInterceptor{},
+		},
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return conns.NewResourceContext(ctx, "test", "Test", "aws_test")
+	}
+
+	var called bool
+	var f schema.CreateContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		called = true
+		return nil
+	}
+	meta := &conns.AWSClient{ReadOnly: true, AuditLogger: logger}
+
+	diags := interceptedHandler(bootstrapContext, interceptors, f, Create)(context.Background(), &schema.ResourceData{}, meta)
+
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if called {
+		t.Error("handler called")
+	}
+	if !diags.HasError() {
+		t.Error("expected error diagnostics")
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if got, want := len(lines), 1; got != want {
+		t.Fatalf("number of audit records = %v, want %v", got, want)
+	}
+
+	var record audit.Record
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := record.Operation, audit.OperationCreate; got != want {
+		t.Errorf("operation = %v, want %v", got, want)
+	}
+	if got, want := record.ResourceType, "aws_test"; got != want {
+		t.Errorf("resource type = %v, want %v", got, want)
+	}
+	if record.Error == "" {
+		t.Error("expected audit record error")
+	}
+}
+
+func TestConcurrencyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	semaphore := tfsync.NewSemaphore("test", 1)
+	interceptors := interceptorItems{
+		{
+			when: Before | Finally,
+			why:  Create | Update | Delete,
+			interceptor: concurrencyInterceptor{
+				semaphore: semaphore,
+			},
+		},
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return conns.NewResourceContext(ctx, "test", "Test", "aws_test")
+	}
+
+	var inUse int64
+	var f schema.CreateContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		inUse = semaphore.Metrics().InUse
+		return nil
+	}
+
+	diags := interceptedHandler(bootstrapContext, interceptors, f, Create)(context.Background(), &schema.ResourceData{}, &conns.AWSClient{})
+
+	if got, want := len(diags), 0; got != want {
+		t.Errorf("length of diags = %v, want %v", got, want)
+	}
+	if got, want := inUse, int64(1); got != want {
+		t.Errorf("in use during handler = %v, want %v", got, want)
+	}
+	if got, want := semaphore.Metrics().InUse, int64(0); got != want {
+		t.Errorf("in use after handler = %v, want %v", got, want)
+	}
+
+	// The semaphore cannot be acquired while it is held elsewhere.
+	release, ok := semaphore.TryAcquire(1)
+	if !ok {
+		t.Fatalf("TryAcquire failed")
+	}
+	defer release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var called bool
+	f = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		called = true
+		return nil
+	}
+
+	diags = interceptedHandler(bootstrapContext, interceptors, f, Delete)(ctx, &schema.ResourceData{}, &conns.AWSClient{})
+
+	if called {
+		t.Errorf("handler called while semaphore held")
+	}
+	if got, want := len(diags), 1; got != want {
+		t.Errorf("length of diags = %v, want %v", got, want)
+	}
+}
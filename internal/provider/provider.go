@@ -14,14 +14,18 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 // New returns a new, initialized Terraform Plugin SDK v2-style provider instance.
@@ -37,6 +41,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The access key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"account_guard": accountGuardSchema(),
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -44,8 +49,28 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				ConflictsWith: []string{"forbidden_account_ids"},
 				Set:           schema.HashString,
 			},
+			"api_rate_limits": apiRateLimitsSchema(),
+			"api_recording_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "File that AWS API interactions are recorded to or replayed from. " +
+					"Can also be configured using the `TF_AWS_API_RECORDING_FILE` environment variable.",
+			},
+			"api_recording_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(conns.APIRecordingMode_Values(), false),
+				Description: "Whether AWS API interactions are recorded to (`record`) or replayed from (`replay`) the API recording file. " +
+					"Can also be configured using the `TF_AWS_API_RECORDING_MODE` environment variable.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "File to which a JSON Lines audit record of each resource operation, " +
+					"including the AWS API operations invoked, is appended.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -56,10 +81,37 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"default_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				MaxItems:    1,
-				Description: "Configuration block with settings to default resource tags across all resources.",
+				Description: "Configuration blocks with settings to default resource tags across all resources or those matching a selector.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource types that the tags are not defaulted on.",
+						},
+						"exclude_services": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Service names that the tags are not defaulted on.",
+						},
+						"include_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource types that the tags are defaulted on.",
+						},
+						"include_services": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Service names that the tags are defaulted on.",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -132,18 +184,85 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"normalize_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to normalize resource tag keys and values across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_case": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tftags.KeyCase_Values(), false),
+							Description:  "Case that resource tag keys are converted to. Valid values are `lower` and `upper`.",
+						},
+						"trim_whitespace": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether leading and trailing whitespace is removed from resource tag keys and values.",
+						},
+						"truncate": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether resource tag keys and values are truncated to 128 and 256 characters respectively.",
+						},
+					},
+				},
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Prevent the provider from creating, updating or deleting any resources.\n" +
+					"Resources can still be read and data sources can still be used.",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with tags that taggable resources must have.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Resource tag key that is required.",
+						},
+						"resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource types that the tag is required on. Defaults to all taggable resources.",
+						},
+						"values": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching allowed tag values. Defaults to any value.",
+						},
+					},
+				},
+			},
+			"resource_groups_tagging_api": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(conns.TaggingAPIMode_Values(), false),
+				Description: "When resources identified by ARN are tagged using the Resource Groups Tagging API. " +
+					"Valid values are `fallback` (only resources whose service has no generic tagging support) and `always`.",
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -262,14 +381,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfigForContext(ctx), v.IgnoreTagsConfig, v.NormalizeTagsConfig)
 				}
 
 				return ctx
 			}
 			interceptors := interceptorItems{}
+
+			// Data sources of global services and data sources that define their own `region` attribute don't support the meta-argument.
+			if _, ok := r.Schema[names.AttrRegion]; !ok && r.Schema != nil && !conns.IsGlobalService(servicePackageName) {
+				r.Schema[names.AttrRegion] = regionSchema(true)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -313,15 +444,47 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfigForContext(ctx), v.IgnoreTagsConfig, v.NormalizeTagsConfig)
 				}
 
 				return ctx
 			}
 			interceptors := interceptorItems{}
 
+			// Resources of global services and resources that define their own `region` attribute don't support the meta-argument.
+			// The Region override must be set before any other interceptors run.
+			if _, ok := r.Schema[names.AttrRegion]; !ok && r.Schema != nil && !conns.IsGlobalService(servicePackageName) {
+				r.Schema[names.AttrRegion] = regionSchema(false)
+
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(regionCustomizeDiff, v)
+				} else {
+					r.CustomizeDiff = regionCustomizeDiff
+				}
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
+
+			// Operations refused by the read-only interceptor are audited.
+			interceptors = append(interceptors,
+				interceptorItem{
+					when:        Before | Finally,
+					why:         AllOps,
+					interceptor: auditInterceptor{},
+				},
+				interceptorItem{
+					when:        Before,
+					why:         Create | Update | Delete,
+					interceptor: readOnlyInterceptor{},
+				},
+			)
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -355,6 +518,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			// Concurrency limits are acquired last so that they are not held while any other Before interceptors run.
+			if v := v.Concurrency; v != nil {
+				key := v.Key
+				if key == "" {
+					key = typeName
+				}
+
+				interceptors = append(interceptors, interceptorItem{
+					when: Before | Finally,
+					why:  Create | Update | Delete,
+					interceptor: concurrencyInterceptor{
+						semaphore: tfsync.GlobalSemaphoreKV.Get(key, v.Limit),
+					},
+				})
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -409,6 +588,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	return provider, nil
 }
 
+// ServicePackages returns the service packages registered with the provider.
+// Resource factories are not wrapped as they are by New.
+func ServicePackages(ctx context.Context) []conns.ServicePackage {
+	return servicePackages(ctx)
+}
+
 // configure ensures that the provider is fully configured.
 func configure(ctx context.Context, provider *schema.Provider, d *schema.ResourceData) (*conns.AWSClient, diag.Diagnostics) {
 	terraformVersion := provider.TerraformVersion
@@ -420,6 +605,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		APIRecordingFile:               d.Get("api_recording_file").(string),
+		APIRecordingMode:               d.Get("api_recording_mode").(string),
+		AuditLogFile:                   d.Get("audit_log_file").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -428,6 +616,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
+		ReadOnly:                       d.Get("read_only").(bool),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
@@ -441,6 +630,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	if v, ok := d.Get("resource_groups_tagging_api").(string); ok && v != "" {
+		config.TaggingAPIMode = v
+	}
+
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
 		mode, err := aws.ParseRetryMode(v)
 		if err != nil {
@@ -449,10 +642,37 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.RetryMode = mode
 	}
 
+	if config.APIRecordingFile == "" {
+		config.APIRecordingFile = os.Getenv(envvar.APIRecordingFile)
+	}
+
+	if config.APIRecordingMode == "" {
+		if v := os.Getenv(envvar.APIRecordingMode); v != "" {
+			if !slices.Contains(conns.APIRecordingMode_Values(), v) {
+				return nil, diag.Errorf("invalid value for %s: %s", envvar.APIRecordingMode, v)
+			}
+			config.APIRecordingMode = v
+		}
+	}
+
+	if v, ok := d.GetOk("account_guard"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.AccountGuardConfig = expandAccountGuard(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("api_rate_limits"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiRateLimits, err := expandAPIRateLimits(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.APIRateLimits = apiRateLimits
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
 		tflog.Info(ctx, "assume_role configuration set", map[string]any{
@@ -471,8 +691,8 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
-	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 {
+		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{}))
 	}
 
 	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
@@ -497,6 +717,20 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("normalize_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.NormalizeTagsConfig = expandNormalizeTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("required_tags"); ok && len(v.([]interface{})) > 0 {
+		requiredTags, err := expandRequiredTags(ctx, v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RequiredTagsConfig = requiredTags
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -528,6 +762,73 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	return meta, diags
 }
 
+func accountGuardSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to restrict the AWS accounts that the provider can be used with.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_account_ids": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "AWS account IDs that are allowed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidAccountID,
+					},
+				},
+				"allowed_organization_ids": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "AWS Organizations organization IDs that are allowed.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"allowed_organizational_unit_paths": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "AWS Organizations organizational unit paths, e.g. `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`, that are allowed. The account's path must start with one of the paths.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"allowed_partitions": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "AWS partitions that are allowed.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"forbidden_account_ids": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "AWS account IDs that are forbidden.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidAccountID,
+					},
+				},
+				"forbidden_organization_ids": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "AWS Organizations organization IDs that are forbidden.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"forbidden_organizational_unit_paths": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "AWS Organizations organizational unit paths, e.g. `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`, that are forbidden. The account is forbidden if its path starts with one of the paths.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"forbidden_partitions": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "AWS partitions that are forbidden.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -656,6 +957,28 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func apiRateLimitsSchema() *schema.Schema {
+	apiRateLimitsAttributes := make(map[string]*schema.Schema)
+
+	for _, serviceKey := range names.Aliases() {
+		apiRateLimitsAttributes[serviceKey] = &schema.Schema{
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+			Description:  "Use this to override the default maximum number of AWS API requests per second",
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: apiRateLimitsAttributes,
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -677,6 +1000,48 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandAccountGuard(_ context.Context, tfMap map[string]interface{}) *conns.AccountGuardConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	accountGuard := conns.AccountGuardConfig{}
+
+	if v, ok := tfMap["allowed_account_ids"].(*schema.Set); ok && v.Len() > 0 {
+		accountGuard.AllowedAccountIDs = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["allowed_organization_ids"].(*schema.Set); ok && v.Len() > 0 {
+		accountGuard.AllowedOrganizationIDs = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["allowed_organizational_unit_paths"].(*schema.Set); ok && v.Len() > 0 {
+		accountGuard.AllowedOrganizationalUnitPaths = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["allowed_partitions"].(*schema.Set); ok && v.Len() > 0 {
+		accountGuard.AllowedPartitions = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["forbidden_account_ids"].(*schema.Set); ok && v.Len() > 0 {
+		accountGuard.ForbiddenAccountIDs = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["forbidden_organization_ids"].(*schema.Set); ok && v.Len() > 0 {
+		accountGuard.ForbiddenOrganizationIDs = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["forbidden_organizational_unit_paths"].(*schema.Set); ok && v.Len() > 0 {
+		accountGuard.ForbiddenOrganizationalUnitPaths = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["forbidden_partitions"].(*schema.Set); ok && v.Len() > 0 {
+		accountGuard.ForbiddenPartitions = flex.ExpandStringValueSet(v)
+	}
+
+	return &accountGuard
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -763,15 +1128,50 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
-func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
-	if tfMap == nil {
+func expandDefaultTags(ctx context.Context, tfList []interface{}) *tftags.DefaultConfig {
+	if len(tfList) == 0 {
 		return nil
 	}
 
 	defaultConfig := &tftags.DefaultConfig{}
 
-	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
-		defaultConfig.Tags = tftags.New(ctx, v)
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		scopedConfig := tftags.ScopedDefaultConfig{}
+
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			scopedConfig.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["exclude_services"].(*schema.Set); ok && v.Len() > 0 {
+			scopedConfig.ExcludeServices = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["include_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			scopedConfig.IncludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["include_services"].(*schema.Set); ok && v.Len() > 0 {
+			scopedConfig.IncludeServices = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+			scopedConfig.Tags = tftags.New(ctx, v)
+		}
+
+		// Blocks without any selector default tags across all resources.
+		if len(scopedConfig.ExcludeResourceTypes) == 0 && len(scopedConfig.ExcludeServices) == 0 && len(scopedConfig.IncludeResourceTypes) == 0 && len(scopedConfig.IncludeServices) == 0 {
+			defaultConfig.Tags = defaultConfig.Tags.Merge(scopedConfig.Tags)
+
+			continue
+		}
+
+		defaultConfig.ScopedTags = append(defaultConfig.ScopedTags, scopedConfig)
 	}
 
 	return defaultConfig
@@ -795,6 +1195,91 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandNormalizeTags(_ context.Context, tfMap map[string]interface{}) *tftags.NormalizeConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	normalizeConfig := &tftags.NormalizeConfig{}
+
+	if v, ok := tfMap["key_case"].(string); ok && v != "" {
+		normalizeConfig.KeyCase = v
+	}
+
+	if v, ok := tfMap["trim_whitespace"].(bool); ok {
+		normalizeConfig.TrimWhitespace = v
+	}
+
+	if v, ok := tfMap["truncate"].(bool); ok {
+		normalizeConfig.Truncate = v
+	}
+
+	return normalizeConfig
+}
+
+func expandRequiredTags(_ context.Context, tfList []interface{}) (*tftags.RequiredConfig, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	requiredConfig := &tftags.RequiredConfig{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var key string
+		var resourceTypes, values []string
+
+		if v, ok := tfMap["key"].(string); ok {
+			key = v
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			resourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+			values = flex.ExpandStringValueSet(v)
+		}
+
+		rule, err := tftags.NewRequiredRule(key, values, resourceTypes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		requiredConfig.Rules = append(requiredConfig.Rules, rule)
+	}
+
+	return requiredConfig, nil
+}
+
+func expandAPIRateLimits(_ context.Context, tfMap map[string]interface{}) (map[string]float64, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	apiRateLimits := make(map[string]float64)
+
+	for _, alias := range names.Aliases() {
+		pkg, err := names.ProviderPackageForAlias(alias)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign API rate limit (%s): %w", alias, err)
+		}
+
+		if v, ok := tfMap[alias].(float64); ok && v > 0 {
+			apiRateLimits[pkg] = v
+		}
+	}
+
+	return apiRateLimits, nil
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
@@ -6,6 +6,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -25,6 +27,110 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestRegionMetaArgument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p, err := New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Global services don't support the meta-argument.
+	if _, ok := p.ResourcesMap["aws_iam_role"].Schema[names.AttrRegion]; ok {
+		t.Errorf("aws_iam_role has a %s attribute", names.AttrRegion)
+	}
+
+	r := p.ResourcesMap["aws_cloudwatch_log_stream"]
+	client := &conns.AWSClient{
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"id":             "test",
+			"log_group_name": "test",
+			"name":           "test",
+			names.AttrRegion: "us-east-1",
+		},
+	}
+
+	testCases := map[string]struct {
+		config          map[string]any
+		expectedReplace bool
+	}{
+		"not configured": {
+			config: map[string]any{
+				"log_group_name": "test",
+				"name":           "test",
+			},
+		},
+		"configured unchanged": {
+			config: map[string]any{
+				"log_group_name": "test",
+				"name":           "test",
+				names.AttrRegion: "us-east-1",
+			},
+		},
+		"configured changed": {
+			config: map[string]any{
+				"log_group_name": "test",
+				"name":           "test",
+				names.AttrRegion: "eu-west-1",
+			},
+			expectedReplace: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(testCase.config), client)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := diff.RequiresNew(), testCase.expectedReplace; got != want {
+				t.Errorf("RequiresNew = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestExpandAPIRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	apiRateLimits := make(map[string]interface{})
+	for _, serviceKey := range names.Aliases() {
+		apiRateLimits[serviceKey] = 0.0
+	}
+	apiRateLimits["route53"] = 2.5
+	apiRateLimits["elbv2"] = 10.0
+
+	results, err := expandAPIRateLimits(ctx, apiRateLimits)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(results) != 2 {
+		t.Errorf("Expected 2 API rate limits, got %d", len(results))
+	}
+
+	if v := results[names.Route53]; v != 2.5 {
+		t.Errorf("Expected API rate limit %v, got %v", 2.5, results)
+	}
+
+	if v := results[names.ELBV2]; v != 10.0 {
+		t.Errorf("Expected API rate limit %v, got %v", 10.0, results)
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -0,0 +1,1423 @@
+// Code generated by internal/generate/schematests/main.go; DO NOT EDIT.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/account"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/amp"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/amplify"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/apigateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appintegrations"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/chime"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/chimesdkmediapipelines"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/chimesdkvoice"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudhsmv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudsearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudtrail"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codebuild"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codecommit"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codegurureviewer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codepipeline"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codestarconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codestarnotifications"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidentity"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/controltower"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cur"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dataexchange"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/datapipeline"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dax"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/detective"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/docdbelastic"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ecrpublic"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/efs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elasticache"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elasticsearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elastictranscoder"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/finspace"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/fis"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/fms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/internetmonitor"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ivs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ivschat"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/keyspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/oam"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rbin"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoveryreadiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rum"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/s3outposts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/serverlessrepo"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmincidents"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
+)
+
+func TestAccessAnalyzerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, accessanalyzer.ServicePackage(context.Background()))
+}
+
+func TestAccountResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, account.ServicePackage(context.Background()))
+}
+
+func TestACMResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, acm.ServicePackage(context.Background()))
+}
+
+func TestACMPCAResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, acmpca.ServicePackage(context.Background()))
+}
+
+func TestAMPResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, amp.ServicePackage(context.Background()))
+}
+
+func TestAmplifyResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, amplify.ServicePackage(context.Background()))
+}
+
+func TestAPIGatewayResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, apigateway.ServicePackage(context.Background()))
+}
+
+func TestAPIGatewayV2ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, apigatewayv2.ServicePackage(context.Background()))
+}
+
+func TestAppAutoScalingResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, appautoscaling.ServicePackage(context.Background()))
+}
+
+func TestAppConfigResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, appconfig.ServicePackage(context.Background()))
+}
+
+func TestAppFlowResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, appflow.ServicePackage(context.Background()))
+}
+
+func TestAppIntegrationsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, appintegrations.ServicePackage(context.Background()))
+}
+
+func TestApplicationInsightsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, applicationinsights.ServicePackage(context.Background()))
+}
+
+func TestAppMeshResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, appmesh.ServicePackage(context.Background()))
+}
+
+func TestAppRunnerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, apprunner.ServicePackage(context.Background()))
+}
+
+func TestAppStreamResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, appstream.ServicePackage(context.Background()))
+}
+
+func TestAppSyncResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, appsync.ServicePackage(context.Background()))
+}
+
+func TestAthenaResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, athena.ServicePackage(context.Background()))
+}
+
+func TestAuditManagerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, auditmanager.ServicePackage(context.Background()))
+}
+
+func TestAutoScalingResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, autoscaling.ServicePackage(context.Background()))
+}
+
+func TestAutoScalingPlansResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, autoscalingplans.ServicePackage(context.Background()))
+}
+
+func TestBackupResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, backup.ServicePackage(context.Background()))
+}
+
+func TestBatchResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, batch.ServicePackage(context.Background()))
+}
+
+func TestBudgetsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, budgets.ServicePackage(context.Background()))
+}
+
+func TestCEResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ce.ServicePackage(context.Background()))
+}
+
+func TestChimeResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, chime.ServicePackage(context.Background()))
+}
+
+func TestChimeSDKMediaPipelinesResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, chimesdkmediapipelines.ServicePackage(context.Background()))
+}
+
+func TestChimeSDKVoiceResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, chimesdkvoice.ServicePackage(context.Background()))
+}
+
+func TestCleanRoomsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, cleanrooms.ServicePackage(context.Background()))
+}
+
+func TestCloud9ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, cloud9.ServicePackage(context.Background()))
+}
+
+func TestCloudControlResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, cloudcontrol.ServicePackage(context.Background()))
+}
+
+func TestCloudFormationResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, cloudformation.ServicePackage(context.Background()))
+}
+
+func TestCloudFrontResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, cloudfront.ServicePackage(context.Background()))
+}
+
+func TestCloudHSMV2ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, cloudhsmv2.ServicePackage(context.Background()))
+}
+
+func TestCloudSearchResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, cloudsearch.ServicePackage(context.Background()))
+}
+
+func TestCloudTrailResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, cloudtrail.ServicePackage(context.Background()))
+}
+
+func TestCloudWatchResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, cloudwatch.ServicePackage(context.Background()))
+}
+
+func TestCodeArtifactResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, codeartifact.ServicePackage(context.Background()))
+}
+
+func TestCodeBuildResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, codebuild.ServicePackage(context.Background()))
+}
+
+func TestCodeCommitResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, codecommit.ServicePackage(context.Background()))
+}
+
+func TestCodeGuruReviewerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, codegurureviewer.ServicePackage(context.Background()))
+}
+
+func TestCodePipelineResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, codepipeline.ServicePackage(context.Background()))
+}
+
+func TestCodeStarConnectionsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, codestarconnections.ServicePackage(context.Background()))
+}
+
+func TestCodeStarNotificationsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, codestarnotifications.ServicePackage(context.Background()))
+}
+
+func TestCognitoIdentityResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, cognitoidentity.ServicePackage(context.Background()))
+}
+
+func TestCognitoIDPResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, cognitoidp.ServicePackage(context.Background()))
+}
+
+func TestComprehendResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, comprehend.ServicePackage(context.Background()))
+}
+
+func TestComputeOptimizerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, computeoptimizer.ServicePackage(context.Background()))
+}
+
+func TestConfigServiceResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, configservice.ServicePackage(context.Background()))
+}
+
+func TestConnectResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, connect.ServicePackage(context.Background()))
+}
+
+func TestControlTowerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, controltower.ServicePackage(context.Background()))
+}
+
+func TestCURResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, cur.ServicePackage(context.Background()))
+}
+
+func TestDataExchangeResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, dataexchange.ServicePackage(context.Background()))
+}
+
+func TestDataPipelineResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, datapipeline.ServicePackage(context.Background()))
+}
+
+func TestDataSyncResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, datasync.ServicePackage(context.Background()))
+}
+
+func TestDAXResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, dax.ServicePackage(context.Background()))
+}
+
+func TestDeployResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, deploy.ServicePackage(context.Background()))
+}
+
+func TestDetectiveResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, detective.ServicePackage(context.Background()))
+}
+
+func TestDeviceFarmResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, devicefarm.ServicePackage(context.Background()))
+}
+
+func TestDirectConnectResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, directconnect.ServicePackage(context.Background()))
+}
+
+func TestDLMResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, dlm.ServicePackage(context.Background()))
+}
+
+func TestDMSResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, dms.ServicePackage(context.Background()))
+}
+
+func TestDocDBResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, docdb.ServicePackage(context.Background()))
+}
+
+func TestDocDBElasticResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, docdbelastic.ServicePackage(context.Background()))
+}
+
+func TestDSResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ds.ServicePackage(context.Background()))
+}
+
+func TestDynamoDBResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, dynamodb.ServicePackage(context.Background()))
+}
+
+func TestEC2ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ec2.ServicePackage(context.Background()))
+}
+
+func TestECRResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ecr.ServicePackage(context.Background()))
+}
+
+func TestECRPublicResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ecrpublic.ServicePackage(context.Background()))
+}
+
+func TestECSResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ecs.ServicePackage(context.Background()))
+}
+
+func TestEFSResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, efs.ServicePackage(context.Background()))
+}
+
+func TestEKSResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, eks.ServicePackage(context.Background()))
+}
+
+func TestElastiCacheResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, elasticache.ServicePackage(context.Background()))
+}
+
+func TestElasticBeanstalkResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, elasticbeanstalk.ServicePackage(context.Background()))
+}
+
+func TestElasticsearchResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, elasticsearch.ServicePackage(context.Background()))
+}
+
+func TestElasticTranscoderResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, elastictranscoder.ServicePackage(context.Background()))
+}
+
+func TestELBResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, elb.ServicePackage(context.Background()))
+}
+
+func TestELBV2ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, elbv2.ServicePackage(context.Background()))
+}
+
+func TestEMRResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, emr.ServicePackage(context.Background()))
+}
+
+func TestEMRContainersResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, emrcontainers.ServicePackage(context.Background()))
+}
+
+func TestEMRServerlessResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, emrserverless.ServicePackage(context.Background()))
+}
+
+func TestEventsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, events.ServicePackage(context.Background()))
+}
+
+func TestEvidentlyResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, evidently.ServicePackage(context.Background()))
+}
+
+func TestFinSpaceResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, finspace.ServicePackage(context.Background()))
+}
+
+func TestFirehoseResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, firehose.ServicePackage(context.Background()))
+}
+
+func TestFISResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, fis.ServicePackage(context.Background()))
+}
+
+func TestFMSResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, fms.ServicePackage(context.Background()))
+}
+
+func TestFSxResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, fsx.ServicePackage(context.Background()))
+}
+
+func TestGameLiftResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, gamelift.ServicePackage(context.Background()))
+}
+
+func TestGlacierResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, glacier.ServicePackage(context.Background()))
+}
+
+func TestGlobalAcceleratorResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, globalaccelerator.ServicePackage(context.Background()))
+}
+
+func TestGlueResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, glue.ServicePackage(context.Background()))
+}
+
+func TestGrafanaResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, grafana.ServicePackage(context.Background()))
+}
+
+func TestGreengrassResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, greengrass.ServicePackage(context.Background()))
+}
+
+func TestGuardDutyResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, guardduty.ServicePackage(context.Background()))
+}
+
+func TestHealthLakeResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, healthlake.ServicePackage(context.Background()))
+}
+
+func TestIAMResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, iam.ServicePackage(context.Background()))
+}
+
+func TestIdentityStoreResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, identitystore.ServicePackage(context.Background()))
+}
+
+func TestImageBuilderResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, imagebuilder.ServicePackage(context.Background()))
+}
+
+func TestInspectorResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, inspector.ServicePackage(context.Background()))
+}
+
+func TestInspector2ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, inspector2.ServicePackage(context.Background()))
+}
+
+func TestInternetMonitorResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, internetmonitor.ServicePackage(context.Background()))
+}
+
+func TestIoTResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, iot.ServicePackage(context.Background()))
+}
+
+func TestIoTAnalyticsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, iotanalytics.ServicePackage(context.Background()))
+}
+
+func TestIoTEventsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, iotevents.ServicePackage(context.Background()))
+}
+
+func TestIVSResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ivs.ServicePackage(context.Background()))
+}
+
+func TestIVSChatResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ivschat.ServicePackage(context.Background()))
+}
+
+func TestKafkaResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, kafka.ServicePackage(context.Background()))
+}
+
+func TestKafkaConnectResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, kafkaconnect.ServicePackage(context.Background()))
+}
+
+func TestKendraResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, kendra.ServicePackage(context.Background()))
+}
+
+func TestKeyspacesResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, keyspaces.ServicePackage(context.Background()))
+}
+
+func TestKinesisResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, kinesis.ServicePackage(context.Background()))
+}
+
+func TestKinesisAnalyticsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, kinesisanalytics.ServicePackage(context.Background()))
+}
+
+func TestKinesisAnalyticsV2ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, kinesisanalyticsv2.ServicePackage(context.Background()))
+}
+
+func TestKinesisVideoResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, kinesisvideo.ServicePackage(context.Background()))
+}
+
+func TestKMSResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, kms.ServicePackage(context.Background()))
+}
+
+func TestLakeFormationResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, lakeformation.ServicePackage(context.Background()))
+}
+
+func TestLambdaResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, lambda.ServicePackage(context.Background()))
+}
+
+func TestLexModelsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, lexmodels.ServicePackage(context.Background()))
+}
+
+func TestLicenseManagerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, licensemanager.ServicePackage(context.Background()))
+}
+
+func TestLightsailResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, lightsail.ServicePackage(context.Background()))
+}
+
+func TestLocationResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, location.ServicePackage(context.Background()))
+}
+
+func TestLogsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, logs.ServicePackage(context.Background()))
+}
+
+func TestMacie2ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, macie2.ServicePackage(context.Background()))
+}
+
+func TestMediaConnectResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, mediaconnect.ServicePackage(context.Background()))
+}
+
+func TestMediaConvertResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, mediaconvert.ServicePackage(context.Background()))
+}
+
+func TestMediaLiveResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, medialive.ServicePackage(context.Background()))
+}
+
+func TestMediaPackageResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, mediapackage.ServicePackage(context.Background()))
+}
+
+func TestMediaStoreResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, mediastore.ServicePackage(context.Background()))
+}
+
+func TestMemoryDBResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, memorydb.ServicePackage(context.Background()))
+}
+
+func TestMetaResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, meta.ServicePackage(context.Background()))
+}
+
+func TestMQResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, mq.ServicePackage(context.Background()))
+}
+
+func TestMWAAResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, mwaa.ServicePackage(context.Background()))
+}
+
+func TestNeptuneResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, neptune.ServicePackage(context.Background()))
+}
+
+func TestNetworkFirewallResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, networkfirewall.ServicePackage(context.Background()))
+}
+
+func TestNetworkManagerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, networkmanager.ServicePackage(context.Background()))
+}
+
+func TestObservabilityAccessManagerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, oam.ServicePackage(context.Background()))
+}
+
+func TestOpenSearchResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, opensearch.ServicePackage(context.Background()))
+}
+
+func TestOpenSearchServerlessResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, opensearchserverless.ServicePackage(context.Background()))
+}
+
+func TestOpsWorksResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, opsworks.ServicePackage(context.Background()))
+}
+
+func TestOrganizationsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, organizations.ServicePackage(context.Background()))
+}
+
+func TestOutpostsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, outposts.ServicePackage(context.Background()))
+}
+
+func TestPinpointResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, pinpoint.ServicePackage(context.Background()))
+}
+
+func TestPipesResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, pipes.ServicePackage(context.Background()))
+}
+
+func TestPricingResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, pricing.ServicePackage(context.Background()))
+}
+
+func TestQLDBResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, qldb.ServicePackage(context.Background()))
+}
+
+func TestQuickSightResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, quicksight.ServicePackage(context.Background()))
+}
+
+func TestRAMResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ram.ServicePackage(context.Background()))
+}
+
+func TestRBinResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, rbin.ServicePackage(context.Background()))
+}
+
+func TestRDSResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, rds.ServicePackage(context.Background()))
+}
+
+func TestRedshiftResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, redshift.ServicePackage(context.Background()))
+}
+
+func TestRedshiftDataResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, redshiftdata.ServicePackage(context.Background()))
+}
+
+func TestRedshiftServerlessResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, redshiftserverless.ServicePackage(context.Background()))
+}
+
+func TestResourceExplorer2ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, resourceexplorer2.ServicePackage(context.Background()))
+}
+
+func TestResourceGroupsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, resourcegroups.ServicePackage(context.Background()))
+}
+
+func TestResourceGroupsTaggingAPIResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, resourcegroupstaggingapi.ServicePackage(context.Background()))
+}
+
+func TestRolesAnywhereResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, rolesanywhere.ServicePackage(context.Background()))
+}
+
+func TestRoute53ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, route53.ServicePackage(context.Background()))
+}
+
+func TestRoute53DomainsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, route53domains.ServicePackage(context.Background()))
+}
+
+func TestRoute53RecoveryControlConfigResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, route53recoverycontrolconfig.ServicePackage(context.Background()))
+}
+
+func TestRoute53RecoveryReadinessResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, route53recoveryreadiness.ServicePackage(context.Background()))
+}
+
+func TestRoute53ResolverResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, route53resolver.ServicePackage(context.Background()))
+}
+
+func TestRUMResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, rum.ServicePackage(context.Background()))
+}
+
+func TestS3ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, s3.ServicePackage(context.Background()))
+}
+
+func TestS3ControlResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, s3control.ServicePackage(context.Background()))
+}
+
+func TestS3OutpostsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, s3outposts.ServicePackage(context.Background()))
+}
+
+func TestSageMakerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, sagemaker.ServicePackage(context.Background()))
+}
+
+func TestSchedulerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, scheduler.ServicePackage(context.Background()))
+}
+
+func TestSchemasResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, schemas.ServicePackage(context.Background()))
+}
+
+func TestSecretsManagerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, secretsmanager.ServicePackage(context.Background()))
+}
+
+func TestSecurityHubResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, securityhub.ServicePackage(context.Background()))
+}
+
+func TestSecurityLakeResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, securitylake.ServicePackage(context.Background()))
+}
+
+func TestServerlessRepoResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, serverlessrepo.ServicePackage(context.Background()))
+}
+
+func TestServiceCatalogResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, servicecatalog.ServicePackage(context.Background()))
+}
+
+func TestServiceDiscoveryResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, servicediscovery.ServicePackage(context.Background()))
+}
+
+func TestServiceQuotasResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, servicequotas.ServicePackage(context.Background()))
+}
+
+func TestSESResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ses.ServicePackage(context.Background()))
+}
+
+func TestSESV2ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, sesv2.ServicePackage(context.Background()))
+}
+
+func TestSFNResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, sfn.ServicePackage(context.Background()))
+}
+
+func TestShieldResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, shield.ServicePackage(context.Background()))
+}
+
+func TestSignerResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, signer.ServicePackage(context.Background()))
+}
+
+func TestSimpleDBResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, simpledb.ServicePackage(context.Background()))
+}
+
+func TestSNSResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, sns.ServicePackage(context.Background()))
+}
+
+func TestSQSResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, sqs.ServicePackage(context.Background()))
+}
+
+func TestSSMResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ssm.ServicePackage(context.Background()))
+}
+
+func TestSSMContactsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ssmcontacts.ServicePackage(context.Background()))
+}
+
+func TestSSMIncidentsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ssmincidents.ServicePackage(context.Background()))
+}
+
+func TestSSOAdminResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, ssoadmin.ServicePackage(context.Background()))
+}
+
+func TestStorageGatewayResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, storagegateway.ServicePackage(context.Background()))
+}
+
+func TestSTSResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, sts.ServicePackage(context.Background()))
+}
+
+func TestSWFResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, swf.ServicePackage(context.Background()))
+}
+
+func TestSyntheticsResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, synthetics.ServicePackage(context.Background()))
+}
+
+func TestTimestreamWriteResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, timestreamwrite.ServicePackage(context.Background()))
+}
+
+func TestTranscribeResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, transcribe.ServicePackage(context.Background()))
+}
+
+func TestTransferResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, transfer.ServicePackage(context.Background()))
+}
+
+func TestVPCLatticeResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, vpclattice.ServicePackage(context.Background()))
+}
+
+func TestWAFResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, waf.ServicePackage(context.Background()))
+}
+
+func TestWAFRegionalResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, wafregional.ServicePackage(context.Background()))
+}
+
+func TestWAFV2ResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, wafv2.ServicePackage(context.Background()))
+}
+
+func TestWorkLinkResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, worklink.ServicePackage(context.Background()))
+}
+
+func TestWorkSpacesResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, workspaces.ServicePackage(context.Background()))
+}
+
+func TestXRayResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	testServicePackageResourceSchemas(t, xray.ServicePackage(context.Background()))
+}
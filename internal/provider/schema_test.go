@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Resources whose CustomizeDiff makes AWS API calls and so cannot be exercised offline.
+var customizeDiffAPICallers = map[string]bool{
+	"aws_cloudcontrolapi_resource":          true,
+	"aws_dx_gateway_association_proposal":   true,
+	"aws_elasticsearch_domain":              true,
+	"aws_opensearch_domain":                 true,
+	"aws_snapshot_create_volume_permission": true,
+}
+
+// testServicePackageResourceSchemas validates the schemas of all the specified service package's resources.
+// No AWS API calls are made.
+func testServicePackageResourceSchemas(t *testing.T, sp conns.ServicePackage) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	for _, v := range sp.SDKResources(ctx) {
+		v := v
+
+		t.Run(v.TypeName, func(t *testing.T) {
+			t.Parallel()
+
+			testSDKResourceSchema(ctx, t, v)
+		})
+	}
+
+	for _, v := range sp.FrameworkResources(ctx) {
+		v := v
+
+		r, err := v.Factory(ctx)
+
+		if err != nil {
+			t.Fatalf("creating %s resource: %s", v.Name, err)
+		}
+
+		var response fwresource.MetadataResponse
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+		t.Run(response.TypeName, func(t *testing.T) {
+			t.Parallel()
+
+			testFrameworkResourceSchema(ctx, t, v, r)
+		})
+	}
+}
+
+func testSDKResourceSchema(ctx context.Context, t *testing.T, v *types.ServicePackageSDKResource) {
+	t.Helper()
+
+	r := v.Factory()
+
+	// ForceNew consistency, Computed/Optional conflicts etc.
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Errorf("validating schema: %s", err)
+	}
+
+	if timeouts := r.Timeouts; timeouts != nil {
+		if timeouts.Create != nil && r.Create == nil && r.CreateContext == nil && r.CreateWithoutTimeout == nil { //nolint:staticcheck // Deprecated fields must also be checked.
+			t.Error("create timeout without create function")
+		}
+		if timeouts.Read != nil && r.Read == nil && r.ReadContext == nil && r.ReadWithoutTimeout == nil { //nolint:staticcheck // Deprecated fields must also be checked.
+			t.Error("read timeout without read function")
+		}
+		if timeouts.Update != nil && r.Update == nil && r.UpdateContext == nil && r.UpdateWithoutTimeout == nil { //nolint:staticcheck // Deprecated fields must also be checked.
+			t.Error("update timeout without update function")
+		}
+		if timeouts.Delete != nil && r.Delete == nil && r.DeleteContext == nil && r.DeleteWithoutTimeout == nil { //nolint:staticcheck // Deprecated fields must also be checked.
+			t.Error("delete timeout without delete function")
+		}
+	}
+
+	if v.Tags != nil {
+		if s, ok := r.Schema[names.AttrTags]; !ok {
+			t.Errorf("tags registered without %q attribute", names.AttrTags)
+		} else if s.Type != schema.TypeMap || !s.Optional {
+			t.Errorf("%q attribute is not an optional map", names.AttrTags)
+		}
+
+		if s, ok := r.Schema[names.AttrTagsAll]; !ok {
+			t.Errorf("tags registered without %q attribute", names.AttrTagsAll)
+		} else if s.Type != schema.TypeMap || !s.Computed {
+			t.Errorf("%q attribute is not a computed map", names.AttrTagsAll)
+		}
+
+		// tags_all is computed by CustomizeDiff.
+		if r.CustomizeDiff == nil {
+			t.Error("tags registered without CustomizeDiff")
+		}
+	}
+
+	if r.CustomizeDiff != nil && !customizeDiffAPICallers[v.TypeName] {
+		if err := testSDKResourceCustomizeDiff(ctx, r); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// testSDKResourceCustomizeDiff plans the creation of a resource from a synthetic configuration.
+// Errors returned by the resource's CustomizeDiff are expected, panics are not.
+func testSDKResourceCustomizeDiff(ctx context.Context, r *schema.Resource) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("planning synthetic configuration: panic: %v", v)
+		}
+	}()
+
+	block := r.CoreConfigSchema()
+
+	b, err := json.Marshal(syntheticConfig(r.Schema))
+
+	if err != nil {
+		return err
+	}
+
+	// Attributes missing from the JSON are null.
+	config, err := ctyjson.Unmarshal(b, block.ImpliedType())
+
+	if err != nil {
+		return fmt.Errorf("creating synthetic configuration: %w", err)
+	}
+
+	// As in PlanResourceChange, the proposed new state is the configuration.
+	state := &terraform.InstanceState{
+		RawConfig: config,
+		RawPlan:   config,
+		RawState:  cty.NullVal(config.Type()),
+	}
+
+	_, _ = r.Diff(ctx, state, terraform.NewResourceConfigShimmed(config, block), &conns.AWSClient{})
+
+	return nil
+}
+
+// syntheticConfig returns a configuration with values for all the specified schema's required attributes.
+func syntheticConfig(s map[string]*schema.Schema) map[string]interface{} {
+	config := make(map[string]interface{})
+
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if v := s[k]; v.Required {
+			config[k] = syntheticValue(v)
+		}
+	}
+
+	return config
+}
+
+func syntheticValue(s *schema.Schema) interface{} {
+	switch s.Type {
+	case schema.TypeBool:
+		return true
+	case schema.TypeInt:
+		return 1
+	case schema.TypeFloat:
+		return 1.0
+	case schema.TypeString:
+		return "synthetic"
+	case schema.TypeList, schema.TypeSet:
+		switch elem := s.Elem.(type) {
+		case *schema.Resource:
+			return []interface{}{syntheticConfig(elem.Schema)}
+		case *schema.Schema:
+			return []interface{}{syntheticValue(elem)}
+		default:
+			return []interface{}{"synthetic"}
+		}
+	case schema.TypeMap:
+		return map[string]interface{}{"synthetic": "synthetic"}
+	default:
+		return nil
+	}
+}
+
+func testFrameworkResourceSchema(ctx context.Context, t *testing.T, v *types.ServicePackageFrameworkResource, r fwresource.Resource) {
+	t.Helper()
+
+	var response fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("reading schema: %v", response.Diagnostics)
+	}
+
+	if diags := response.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Errorf("validating schema: %v", diags)
+	}
+
+	for k, v := range response.Schema.Attributes {
+		if v.IsRequired() && (v.IsOptional() || v.IsComputed()) {
+			t.Errorf("required attribute %q is also optional or computed", k)
+		}
+
+		// The equivalent of ForceNew on a Computed-only attribute.
+		if v.IsComputed() && !v.IsOptional() && hasRequiresReplace(v) {
+			t.Errorf("read-only attribute %q requires replacement", k)
+		}
+	}
+
+	if importsByID(r) {
+		if _, ok := response.Schema.Attributes[names.AttrID]; !ok {
+			t.Errorf("imported by ID without %q attribute", names.AttrID)
+		}
+	}
+
+	// Unlike the Plugin SDK, the Plugin Framework requires an Update method, so a resource
+	// whose arguments are not all replaced on change but which cannot be updated is not detected.
+
+	if v.Tags != nil {
+		if _, ok := response.Schema.Attributes[names.AttrTags]; !ok {
+			t.Errorf("tags registered without %q attribute", names.AttrTags)
+		}
+
+		if v, ok := response.Schema.Attributes[names.AttrTagsAll]; !ok {
+			t.Errorf("tags registered without %q attribute", names.AttrTagsAll)
+		} else if !v.IsComputed() {
+			t.Errorf("%q attribute is not computed", names.AttrTagsAll)
+		}
+
+		// tags_all is computed by ModifyPlan.
+		if _, ok := r.(fwresource.ResourceWithModifyPlan); !ok {
+			t.Error("tags registered without ModifyPlan")
+		}
+	}
+}
+
+// hasRequiresReplace returns whether the specified attribute has a RequiresReplace (or RequiresReplaceIf) plan modifier.
+func hasRequiresReplace(attr interface{}) bool {
+	v := reflect.ValueOf(attr)
+
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+
+	planModifiers := v.FieldByName("PlanModifiers")
+
+	if !planModifiers.IsValid() || planModifiers.Kind() != reflect.Slice {
+		return false
+	}
+
+	for i := 0; i < planModifiers.Len(); i++ {
+		if m := planModifiers.Index(i); !m.IsNil() && strings.HasPrefix(m.Elem().Type().Name(), "requiresReplace") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// importsByID returns whether the specified resource embeds framework.WithImportByID.
+func importsByID(r fwresource.Resource) bool {
+	v := reflect.Indirect(reflect.ValueOf(r))
+
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+
+	f, ok := v.Type().FieldByName("WithImportByID")
+
+	return ok && f.Type == reflect.TypeOf(framework.WithImportByID{})
+}
@@ -62,6 +62,8 @@ func tagsUpdateFunc(ctx context.Context, d schemaResourceData, sp conns.ServiceP
 	// if tags_all was computed because not wholly known
 	// Merge the resource's configured tags with any provider configured default_tags.
 	configAll := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, configTags))
+	// Apply any provider configured normalize_tags.
+	configAll = configAll.Normalize(tagsInContext.NormalizeConfig)
 	// Remove system tags.
 	configAll = configAll.IgnoreSystem(inContext.ServicePackageName)
 
@@ -70,6 +72,7 @@ func tagsUpdateFunc(ctx context.Context, d schemaResourceData, sp conns.ServiceP
 
 	// If the service package has a generic resource update tags methods, call it.
 	var err error
+	sp = meta.(*conns.AWSClient).TagsServicePackage(sp, identifier)
 
 	if v, ok := sp.(interface {
 		UpdateTags(context.Context, any, string, any, any) error
@@ -114,6 +117,7 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 	// https://github.com/hashicorp/terraform-provider-aws/issues/31180
 	if identifier != "" {
 		var err error
+		sp := meta.(*conns.AWSClient).TagsServicePackage(sp, identifier)
 
 		if v, ok := sp.(interface {
 			ListTags(context.Context, any, string) error
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -74,8 +75,10 @@ func TestTagsInterceptor(t *testing.T) {
 		ServicePackages: map[string]conns.ServicePackage{
 			"Test": &mockService{},
 		},
-		DefaultTagsConfig: expandDefaultTags(context.Background(), map[string]interface{}{
-			"tag": "",
+		DefaultTagsConfig: expandDefaultTags(context.Background(), []interface{}{
+			map[string]interface{}{
+				"tag": "",
+			},
 		}),
 		IgnoreTagsConfig: expandIgnoreTags(context.Background(), map[string]interface{}{
 			"tag2": "tag",
@@ -83,9 +86,9 @@ func TestTagsInterceptor(t *testing.T) {
 	}
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+		ctx = conns.NewResourceContext(ctx, "Test", "aws_test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
-			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig, v.NormalizeTagsConfig)
 		}
 
 		return ctx
@@ -144,3 +147,7 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 func (d *resourceData) HasChange(key string) bool {
 	return false
 }
+
+func (d *resourceData) Timeout(key string) time.Duration {
+	return 0
+}
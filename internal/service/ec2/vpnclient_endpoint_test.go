@@ -3,7 +3,6 @@ package ec2_test
 import (
 	"context"
 	"fmt"
-	"os"
 	"regexp"
 	"testing"
 
@@ -13,24 +12,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const clientVPNEndpointDefaultLimit = 5
 
-var testAccEc2ClientVpnEndpointSemaphore sync.Semaphore
+var testAccEc2ClientVpnEndpointSemaphore *sync.Semaphore
 
 func init() {
 	testAccEc2ClientVpnEndpointSemaphore = sync.InitializeSemaphore("AWS_EC2_CLIENT_VPN_LIMIT", clientVPNEndpointDefaultLimit)
 }
 
-// This is part of an experimental feature, do not use this as a starting point for tests
-//
-//	"This place is not a place of honor... no highly esteemed deed is commemorated here... nothing valued is here.
-//	What is here was dangerous and repulsive to us. This message is a warning about danger."
-//	--  https://hyperallergic.com/312318/a-nuclear-warning-designed-to-last-10000-years/
+// Client VPN endpoint tests are limited by testAccEc2ClientVpnEndpointSemaphore.
 func TestAccClientVPNEndpoint_serial(t *testing.T) {
 	t.Parallel()
 
@@ -76,14 +71,7 @@ func TestAccClientVPNEndpoint_serial(t *testing.T) {
 		m := m
 		for name, tc := range m {
 			tc := tc
-			t.Run(fmt.Sprintf("%s_%s", group, name), func(t *testing.T) {
-				t.Cleanup(func() {
-					if os.Getenv(resource.EnvTfAcc) != "" {
-						testAccEc2ClientVpnEndpointSemaphore.Notify()
-					}
-				})
-				tc(t)
-			})
+			t.Run(fmt.Sprintf("%s_%s", group, name), tc)
 		}
 	}
 }
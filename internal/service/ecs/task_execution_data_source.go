@@ -283,8 +283,9 @@ func dataSourceTaskExecutionRead(ctx context.Context, d *schema.ResourceData, me
 		TaskDefinition: aws.String(taskDefinition),
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfigForContext(ctx)
+	normalizeTagsConfig := meta.(*conns.AWSClient).NormalizeTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{}))).Normalize(normalizeTagsConfig)
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -186,6 +186,8 @@ func ResourceGameServerGroup() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
@@ -54,6 +54,56 @@ func TestAccGameLiftGameServerGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccGameLiftGameServerGroup_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_game_server_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGameServerGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGameServerGroupConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameServerGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"vpc_subnets"},
+			},
+			{
+				Config: testAccGameServerGroupConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameServerGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccGameServerGroupConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameServerGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGameLiftGameServerGroup_AutoScalingPolicy(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -739,6 +789,75 @@ resource "aws_gamelift_game_server_group" "test" {
 `, rName))
 }
 
+func testAccGameServerGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		testAccGameServerGroupConfig_iam(rName, "test"),
+		testAccGameServerGroupInstanceTypeOfferingsConfig(),
+		testAccGameServerGroupLaunchTemplateConfig(rName),
+		fmt.Sprintf(`
+resource "aws_gamelift_game_server_group" "test" {
+  game_server_group_name = %[1]q
+  dynamic "instance_definition" {
+    for_each = data.aws_ec2_instance_type_offerings.available.instance_types
+    content {
+      instance_type = instance_definition.value
+    }
+  }
+  launch_template {
+    id = aws_launch_template.test.id
+  }
+
+  max_size = 1
+  min_size = 1
+  role_arn = aws_iam_role.test.arn
+
+  vpc_subnets = [data.aws_subnets.test.ids[0]]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccGameServerGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		testAccGameServerGroupConfig_iam(rName, "test"),
+		testAccGameServerGroupInstanceTypeOfferingsConfig(),
+		testAccGameServerGroupLaunchTemplateConfig(rName),
+		fmt.Sprintf(`
+resource "aws_gamelift_game_server_group" "test" {
+  game_server_group_name = %[1]q
+  dynamic "instance_definition" {
+    for_each = data.aws_ec2_instance_type_offerings.available.instance_types
+    content {
+      instance_type = instance_definition.value
+    }
+  }
+  launch_template {
+    id = aws_launch_template.test.id
+  }
+
+  max_size = 1
+  min_size = 1
+  role_arn = aws_iam_role.test.arn
+
+  vpc_subnets = [data.aws_subnets.test.ids[0]]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
 func testAccGameServerGroupConfig_autoScalingPolicy(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
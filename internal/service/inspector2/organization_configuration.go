@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_inspector2_organization_configuration")
+// @Concurrency(limit=1)
 func ResourceOrganizationConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOrganizationConfigurationCreate,
@@ -66,7 +67,6 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 
 const (
 	ResNameOrganizationConfiguration = "Organization Configuration"
-	orgConfigMutex                   = "f14b54d7-2b10-58c2-9c1b-c48260a4825d"
 )
 
 func resourceOrganizationConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -114,9 +114,6 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		return nil
 	}
 
-	conns.GlobalMutexKV.Lock(orgConfigMutex)
-	defer conns.GlobalMutexKV.Unlock(orgConfigMutex)
-
 	log.Printf("[DEBUG] Updating Inspector2 Organization Configuration (%s): %#v", d.Id(), in)
 	_, err := conn.UpdateOrganizationConfiguration(ctx, in)
 	if err != nil {
@@ -133,9 +130,6 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
 
-	conns.GlobalMutexKV.Lock(orgConfigMutex)
-	defer conns.GlobalMutexKV.Unlock(orgConfigMutex)
-
 	in := &inspector2.UpdateOrganizationConfigurationInput{
 		AutoEnable: &types.AutoEnable{
 			Ec2:    aws.Bool(false),
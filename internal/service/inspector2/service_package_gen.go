@@ -43,6 +43,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceOrganizationConfiguration,
 			TypeName: "aws_inspector2_organization_configuration",
+			Concurrency: &types.ServicePackageResourceConcurrency{
+				Limit: 1,
+			},
 		},
 	}
 }
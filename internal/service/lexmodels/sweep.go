@@ -4,6 +4,7 @@
 package lexmodels
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
@@ -20,6 +22,7 @@ func init() {
 		F:    sweepBotAliases,
 	})
 
+	// Any bot aliases created after the aws_lex_bot_alias sweeper has run are swept with their bots.
 	resource.AddTestSweepers("aws_lex_bot", &resource.Sweeper{
 		Name:         "aws_lex_bot",
 		F:            sweepBots,
@@ -59,39 +62,13 @@ func sweepBotAliases(region string) error {
 		}
 
 		for _, bot := range page.Bots {
-			input := &lexmodelbuildingservice.GetBotAliasesInput{
-				BotName: bot.Name,
-			}
-
-			err := conn.GetBotAliasesPagesWithContext(ctx, input, func(page *lexmodelbuildingservice.GetBotAliasesOutput, lastPage bool) bool {
-				if page == nil {
-					return !lastPage
-				}
-
-				for _, botAlias := range page.BotAliases {
-					r := ResourceBotAlias()
-					d := r.Data(nil)
-
-					d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(bot.Name), aws.StringValue(botAlias.Name)))
-					d.Set("bot_name", bot.Name)
-					d.Set("name", botAlias.Name)
-
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
-				}
-
-				return !lastPage
-			})
+			v, err := botAliasSweepables(ctx, conn, client, aws.StringValue(bot.Name))
 
 			if err != nil {
 				errs = multierror.Append(errs, fmt.Errorf("error listing Lex Bot Alias for %s: %w", region, err))
 			}
 
-			r := ResourceBotAlias()
-			d := r.Data(nil)
-
-			d.SetId(aws.StringValue(bot.Name))
-
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, v...)
 		}
 
 		return !lastPage
@@ -113,6 +90,35 @@ func sweepBotAliases(region string) error {
 	return errs.ErrorOrNil()
 }
 
+// botAliasSweepables returns the aliases of the specified bot.
+func botAliasSweepables(ctx context.Context, conn *lexmodelbuildingservice.LexModelBuildingService, client *conns.AWSClient, botName string) ([]sweep.Sweepable, error) {
+	input := &lexmodelbuildingservice.GetBotAliasesInput{
+		BotName: aws.String(botName),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err := conn.GetBotAliasesPagesWithContext(ctx, input, func(page *lexmodelbuildingservice.GetBotAliasesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, botAlias := range page.BotAliases {
+			r := ResourceBotAlias()
+			d := r.Data(nil)
+
+			d.SetId(fmt.Sprintf("%s:%s", botName, aws.StringValue(botAlias.Name)))
+			d.Set("bot_name", botName)
+			d.Set("name", botAlias.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	return sweepResources, err
+}
+
 func sweepBots(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -122,7 +128,13 @@ func sweepBots(region string) error {
 	}
 
 	conn := client.LexModelsConn(ctx)
-	sweepResources := make([]sweep.Sweepable, 0)
+	botAliases := sweep.SweepGroup{
+		Name: "aws_lex_bot_alias",
+	}
+	bots := sweep.SweepGroup{
+		Name:         "aws_lex_bot",
+		Dependencies: []string{botAliases.Name},
+	}
 	var errs *multierror.Error
 
 	input := &lexmodelbuildingservice.GetBotsInput{}
@@ -133,12 +145,20 @@ func sweepBots(region string) error {
 		}
 
 		for _, bot := range page.Bots {
+			v, err := botAliasSweepables(ctx, conn, client, aws.StringValue(bot.Name))
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Lex Bot Alias for %s: %w", region, err))
+			}
+
+			botAliases.Sweepables = append(botAliases.Sweepables, v...)
+
 			r := ResourceBot()
 			d := r.Data(nil)
 
 			d.SetId(aws.StringValue(bot.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			bots.Sweepables = append(bots.Sweepables, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
@@ -148,7 +168,14 @@ func sweepBots(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error listing Lex Bot for %s: %w", region, err))
 	}
 
-	if err = sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+	// A bot cannot be deleted while it has aliases.
+	report, err := sweep.SweepOrchestratorWithDependencies(ctx, []sweep.SweepGroup{botAliases, bots})
+
+	if report != nil {
+		log.Printf("[INFO] Lex Bot sweep for %s:\n%s", region, report)
+	}
+
+	if err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Lex Bot for %s: %w", region, err))
 	}
 
@@ -1,3 +1,4 @@
+//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -Find -InputPaginator=PageToken -OutputPaginator=NextPageToken -ListOps=GetInstances,GetStaticIps
 //go:generate go run ../../generate/tags/main.go -AWSSDKVersion=2 -ListTagsInIDElem=ResourceName -ServiceTagsSlice -TagInIDElem=ResourceName -UpdateTags
 //go:generate go run ../../generate/servicepackage/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
@@ -89,19 +89,12 @@ func sweepInstances(region string) error {
 	input := &lightsail.GetInstancesInput{}
 	var sweeperErrs *multierror.Error
 
-	for {
-		output, err := conn.GetInstances(ctx, input)
-
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Lightsail Instance sweep for %s: %s", region, err)
-			return nil
-		}
-
-		if err != nil {
-			return fmt.Errorf("Error retrieving Lightsail Instances: %s", err)
+	err = getInstancesPages(ctx, conn, input, func(page *lightsail.GetInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		for _, instance := range output.Instances {
+		for _, instance := range page.Instances {
 			name := aws.ToString(instance.Name)
 			input := &lightsail.DeleteInstanceInput{
 				InstanceName: instance.Name,
@@ -117,11 +110,16 @@ func sweepInstances(region string) error {
 			}
 		}
 
-		if aws.ToString(output.NextPageToken) == "" {
-			break
-		}
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lightsail Instance sweep for %s: %s", region, err)
+		return nil
+	}
 
-		input.PageToken = output.NextPageToken
+	if err != nil {
+		return fmt.Errorf("Error retrieving Lightsail Instances: %s", err)
 	}
 
 	return sweeperErrs.ErrorOrNil()
@@ -136,39 +134,37 @@ func sweepStaticIPs(region string) error {
 	conn := client.LightsailClient(ctx)
 
 	input := &lightsail.GetStaticIpsInput{}
+	var sweeperErrs *multierror.Error
 
-	for {
-		output, err := conn.GetStaticIps(ctx, input)
-		if err != nil {
-			if sweep.SkipSweepError(err) {
-				log.Printf("[WARN] Skipping Lightsail Static IP sweep for %s: %s", region, err)
-				return nil
-			}
-			return fmt.Errorf("Error retrieving Lightsail Static IPs: %s", err)
-		}
-
-		if len(output.StaticIps) == 0 {
-			log.Print("[DEBUG] No Lightsail Static IPs to sweep")
-			return nil
+	err = getStaticIPsPages(ctx, conn, input, func(page *lightsail.GetStaticIpsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		for _, staticIp := range output.StaticIps {
+		for _, staticIp := range page.StaticIps {
 			name := aws.ToString(staticIp.Name)
 
 			log.Printf("[INFO] Deleting Lightsail Static IP %s", name)
 			_, err := conn.ReleaseStaticIp(ctx, &lightsail.ReleaseStaticIpInput{
 				StaticIpName: aws.String(name),
 			})
+
 			if err != nil {
-				return fmt.Errorf("Error deleting Lightsail Static IP %s: %s", name, err)
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error deleting Lightsail Static IP %s: %s", name, err))
 			}
 		}
 
-		if output.NextPageToken == nil {
-			break
-		}
-		input.PageToken = output.NextPageToken
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lightsail Static IP sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error retrieving Lightsail Static IPs: %s", err)
 	}
 
-	return nil
+	return sweeperErrs.ErrorOrNil()
 }
@@ -128,6 +128,8 @@ func ResourceFindingsFilter() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -90,6 +91,8 @@ func ResourceMember() *schema.Resource {
 			Create: schema.DefaultTimeout(60 * time.Second),
 			Update: schema.DefaultTimeout(60 * time.Second),
 		},
+
+		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
@@ -0,0 +1,130 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @FrameworkDataSource
+func newDataSourceEffectiveTags(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceEffectiveTags{}, nil
+}
+
+type dataSourceEffectiveTags struct {
+	framework.DataSourceWithConfigure
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceEffectiveTags) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_effective_tags"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceEffectiveTags) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"resource_type": schema.StringAttribute{
+				Optional: true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags_all": tftags.TagsAttributeComputedOnly(),
+			"tags_sources": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceEffectiveTags) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceEffectiveTagsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	meta := d.Meta()
+	defaultTagsConfig := meta.DefaultTagsConfig.Normalize(meta.NormalizeTagsConfig)
+	ignoreTagsConfig := meta.IgnoreTagsConfig
+	normalizeTagsConfig := meta.NormalizeTagsConfig
+	id := "aws"
+
+	if typeName := data.ResourceType.ValueString(); typeName != "" {
+		servicePackageName, err := servicePackageNameForResourceType(ctx, meta, typeName)
+
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("resource_type"), "Invalid resource type", err.Error())
+
+			return
+		}
+
+		// Resolve the default tags in the resource's own Context, as its tags diff does.
+		defaultTagsConfig = meta.DefaultTagsConfigForContext(conns.NewResourceContext(ctx, servicePackageName, "", typeName))
+		id = typeName
+	}
+
+	tags := tftags.New(ctx, data.Tags).Normalize(normalizeTagsConfig)
+
+	data.ID = types.StringValue(id)
+	data.TagsAll = flex.FlattenFrameworkStringValueMapLegacy(ctx, defaultTagsConfig.MergeTags(tags).IgnoreConfig(ignoreTagsConfig).Map())
+	data.TagsSources = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.Sources(defaultTagsConfig, ignoreTagsConfig))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// servicePackageNameForResourceType returns the name of the service package that implements the specified resource type.
+func servicePackageNameForResourceType(ctx context.Context, meta *conns.AWSClient, typeName string) (string, error) {
+	for _, sp := range meta.ServicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.TypeName == typeName {
+				return sp.ServicePackageName(), nil
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+
+			if err != nil {
+				return "", err
+			}
+
+			metadataResponse := resource.MetadataResponse{}
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &metadataResponse)
+
+			if metadataResponse.TypeName == typeName {
+				return sp.ServicePackageName(), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("resource type (%s) not found", typeName)
+}
+
+type dataSourceEffectiveTagsData struct {
+	ID           types.String `tfsdk:"id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Tags         types.Map    `tfsdk:"tags"`
+	TagsAll      types.Map    `tfsdk:"tags_all"`
+	TagsSources  types.Map    `tfsdk:"tags_sources"`
+}
@@ -0,0 +1,101 @@
+package meta_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+)
+
+func TestAccMetaEffectiveTagsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_effective_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckEmulatorCompatible(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("first", "default", "second", "default"),
+					testAccEffectiveTagsDataSourceConfig_tags1("second", "resource"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.first", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.second", "resource"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_sources.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_sources.first", "default_tags"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_sources.second", "tags"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaEffectiveTagsDataSource_ignore(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_effective_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckEmulatorCompatible(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeys1("Tabac", "Louis Chiron"),
+					testAccEffectiveTagsDataSourceConfig_tags1("key1", "value1"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.key1", "value1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_sources.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_sources.Tabac", "ignore_tags"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_sources.key1", "tags"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaEffectiveTagsDataSource_invalidResourceType(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckEmulatorCompatible(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEffectiveTagsDataSourceConfig_resourceType("aws_not_a_resource"),
+				ExpectError: regexp.MustCompile(`resource type \(aws_not_a_resource\) not found`),
+			},
+		},
+	})
+}
+
+func testAccEffectiveTagsDataSourceConfig_tags1(key1, value1 string) string {
+	return fmt.Sprintf(`
+data "aws_effective_tags" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, key1, value1)
+}
+
+func testAccEffectiveTagsDataSourceConfig_resourceType(resourceType string) string {
+	return fmt.Sprintf(`
+data "aws_effective_tags" "test" {
+  resource_type = %[1]q
+}
+`, resourceType)
+}
@@ -22,6 +22,9 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 		{
 			Factory: newDataSourceDefaultTags,
 		},
+		{
+			Factory: newDataSourceEffectiveTags,
+		},
 		{
 			Factory: newDataSourceIPRanges,
 		},
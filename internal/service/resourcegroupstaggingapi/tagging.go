@@ -0,0 +1,293 @@
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// This service package's generic ListTags and UpdateTags methods are used to tag resources in other
+// service packages when the provider is configured to use the Resource Groups Tagging API.
+// See conns.AWSClient.TagsServicePackage.
+
+const (
+	// Maximum number of resources in a TagResources or UntagResources request.
+	batchMaxSize = 20
+	// Time to wait for identical tagging operations on other resources before sending a request.
+	batchDelay = 1 * time.Second
+	// Maximum time to perform a batch.
+	batchTimeout = 5 * time.Minute
+)
+
+var (
+	tagBatcher   = newBatcher(batchMaxSize, batchDelay)
+	untagBatcher = newBatcher(batchMaxSize, batchDelay)
+)
+
+// batchFunc performs a tagging operation on a batch of resources.
+// It returns any per-resource errors keyed by ARN.
+type batchFunc func(context.Context, []string) (map[string]error, error)
+
+// batcher groups identical tagging operations on different resources into batches.
+type batcher struct {
+	delay   time.Duration
+	maxSize int
+
+	mu      sync.Mutex
+	pending map[string]*batch // Keyed by operation.
+}
+
+type batch struct {
+	arns   []string
+	done   chan struct{}
+	err    error
+	errors map[string]error // Keyed by ARN.
+}
+
+func newBatcher(maxSize int, delay time.Duration) *batcher {
+	return &batcher{
+		delay:   delay,
+		maxSize: maxSize,
+		pending: make(map[string]*batch),
+	}
+}
+
+// do adds the resource with the specified ARN to the batch for the specified operation and waits for the batch to be performed.
+// The batch is performed when it is full or after the batcher's delay.
+// The batch is shared by several callers so it is performed with a Context that is not canceled with the caller's Context.
+// Each caller only waits on its own Context.
+func (b *batcher) do(ctx context.Context, key, arn string, f batchFunc) error {
+	b.mu.Lock()
+
+	v, ok := b.pending[key]
+	if !ok {
+		v = &batch{
+			done: make(chan struct{}),
+		}
+		b.pending[key] = v
+
+		ctx := withoutCancel(ctx)
+		time.AfterFunc(b.delay, func() {
+			b.flush(ctx, key, v, f)
+		})
+	}
+
+	v.arns = append(v.arns, arn)
+	full := len(v.arns) >= b.maxSize
+	if full {
+		delete(b.pending, key)
+	}
+
+	b.mu.Unlock()
+
+	if full {
+		go v.perform(withoutCancel(ctx), f)
+	}
+
+	select {
+	case <-v.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if v.err != nil {
+		return v.err
+	}
+
+	return v.errors[arn]
+}
+
+// flush performs the batch if it's still pending.
+func (b *batcher) flush(ctx context.Context, key string, v *batch, f batchFunc) {
+	b.mu.Lock()
+
+	if b.pending[key] != v {
+		b.mu.Unlock()
+		return
+	}
+	delete(b.pending, key)
+
+	b.mu.Unlock()
+
+	v.perform(ctx, f)
+}
+
+func (v *batch) perform(ctx context.Context, f batchFunc) {
+	ctx, cancel := context.WithTimeout(ctx, batchTimeout)
+	defer cancel()
+
+	v.errors, v.err = f(ctx, v.arns)
+	close(v.done)
+}
+
+// withoutCancel returns a Context that has the values of the specified Context but is never canceled and has no deadline.
+func withoutCancel(ctx context.Context) context.Context {
+	return valuesOnlyContext{ctx}
+}
+
+type valuesOnlyContext struct {
+	context.Context
+}
+
+func (valuesOnlyContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (valuesOnlyContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (valuesOnlyContext) Err() error {
+	return nil
+}
+
+// batchKey returns a key identifying an operation with the specified parameters on the specified API client.
+func batchKey(conn resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, operation string, parameters any) (string, error) {
+	b, err := json.Marshal(parameters) // Map keys are sorted.
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%p/%s/%s", conn, operation, b), nil
+}
+
+func failedResourcesErrors(failedResources map[string]*resourcegroupstaggingapi.FailureInfo) map[string]error {
+	errs := make(map[string]error, len(failedResources))
+
+	for arn, v := range failedResources {
+		if v == nil {
+			continue
+		}
+
+		errs[arn] = fmt.Errorf("%s: %s", aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage))
+	}
+
+	return errs
+}
+
+// listTags lists the tags of the resource with the specified ARN.
+func listTags(ctx context.Context, conn resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, identifier string) (tftags.KeyValueTags, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceARNList: aws.StringSlice([]string{identifier}),
+	}
+
+	output, err := conn.GetResourcesWithContext(ctx, input)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	for _, v := range output.ResourceTagMappingList {
+		if v != nil && aws.StringValue(v.ResourceARN) == identifier {
+			return KeyValueTags(ctx, v.Tags), nil
+		}
+	}
+
+	return tftags.New(ctx, nil), nil
+}
+
+// ListTags lists the tags of the resource with the specified ARN and sets them in Context.
+// It is called from outside this package.
+func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	tags, err := listTags(ctx, meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx), identifier)
+
+	if err != nil {
+		return err
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = types.Some(tags)
+	}
+
+	return nil
+}
+
+// updateTags updates the tags of the resource with the specified ARN.
+// Identical updates to the tags of different resources are sent in batches.
+func updateTags(ctx context.Context, conn resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, identifier string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	removedTags := oldTags.Removed(newTags)
+	removedTags = removedTags.IgnoreSystem(names.ResourceGroupsTaggingAPI)
+	if len(removedTags) > 0 {
+		keys := removedTags.Keys()
+		sort.Strings(keys)
+
+		key, err := batchKey(conn, "UntagResources", keys)
+
+		if err != nil {
+			return err
+		}
+
+		err = untagBatcher.do(ctx, key, identifier, func(ctx context.Context, arns []string) (map[string]error, error) {
+			input := &resourcegroupstaggingapi.UntagResourcesInput{
+				ResourceARNList: aws.StringSlice(arns),
+				TagKeys:         aws.StringSlice(keys),
+			}
+
+			output, err := conn.UntagResourcesWithContext(ctx, input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return failedResourcesErrors(output.FailedResourcesMap), nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	updatedTags := oldTags.Updated(newTags)
+	updatedTags = updatedTags.IgnoreSystem(names.ResourceGroupsTaggingAPI)
+	if len(updatedTags) > 0 {
+		tags := updatedTags.Map()
+
+		key, err := batchKey(conn, "TagResources", tags)
+
+		if err != nil {
+			return err
+		}
+
+		err = tagBatcher.do(ctx, key, identifier, func(ctx context.Context, arns []string) (map[string]error, error) {
+			input := &resourcegroupstaggingapi.TagResourcesInput{
+				ResourceARNList: aws.StringSlice(arns),
+				Tags:            aws.StringMap(tags),
+			}
+
+			output, err := conn.TagResourcesWithContext(ctx, input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return failedResourcesErrors(output.FailedResourcesMap), nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateTags updates the tags of the resource with the specified ARN.
+// It is called from outside this package.
+func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
+	return updateTags(ctx, meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx), identifier, oldTags, newTags)
+}
@@ -0,0 +1,132 @@
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBatcher(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := newBatcher(3, 200*time.Millisecond)
+
+	var mu sync.Mutex
+	var batches [][]string
+	f := func(ctx context.Context, arns []string) (map[string]error, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		arns = append([]string(nil), arns...)
+		sort.Strings(arns)
+		batches = append(batches, arns)
+
+		return map[string]error{"arn:aws:test:::r2": errors.New("failed")}, nil
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+
+	for i := 0; i < 4; i++ {
+		i := i
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			errs[i] = b.do(ctx, "key", fmt.Sprintf("arn:aws:test:::r%d", i), f)
+		}()
+	}
+
+	wg.Wait()
+
+	// One full batch of 3 and one batch of 1 flushed after the delay.
+	if got, want := len(batches), 2; got != want {
+		t.Fatalf("batches = %d, want %d", got, want)
+	}
+
+	sort.Slice(batches, func(i, j int) bool { return len(batches[i]) > len(batches[j]) })
+	if got, want := []int{len(batches[0]), len(batches[1])}, []int{3, 1}; !cmp.Equal(got, want) {
+		t.Errorf("batch sizes = %v, want %v", got, want)
+	}
+
+	for i, err := range errs {
+		if got, want := err != nil, i == 2; got != want {
+			t.Errorf("resource %d: err = %v, want error %t", i, err, want)
+		}
+	}
+}
+
+func TestBatcherError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := newBatcher(20, 10*time.Millisecond)
+
+	f := func(ctx context.Context, arns []string) (map[string]error, error) {
+		return nil, errors.New("throttled")
+	}
+
+	if err := b.do(ctx, "key", "arn:aws:test:::r1", f); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestBatcherCanceled(t *testing.T) {
+	t.Parallel()
+
+	b := newBatcher(20, 100*time.Millisecond)
+
+	var mu sync.Mutex
+	var batchErr error
+	f := func(ctx context.Context, arns []string) (map[string]error, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		batchErr = ctx.Err()
+
+		return nil, nil
+	}
+
+	// The first caller's Context is canceled before the batch is performed.
+	ctx1, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var err1, err2 error
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		err1 = b.do(ctx1, "key", "arn:aws:test:::r1", f)
+	}()
+
+	time.Sleep(time.Millisecond)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		err2 = b.do(context.Background(), "key", "arn:aws:test:::r2", f)
+	}()
+
+	wg.Wait()
+
+	if !errors.Is(err1, context.DeadlineExceeded) {
+		t.Errorf("first caller: err = %v, want %v", err1, context.DeadlineExceeded)
+	}
+	if err2 != nil {
+		t.Errorf("second caller: unexpected error: %s", err2)
+	}
+	if batchErr != nil {
+		t.Errorf("batch Context: err = %v, want nil", batchErr)
+	}
+}
@@ -0,0 +1,148 @@
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-aws/internal/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// SweepGroup is a set of sweepable resources of a single resource type, e.g. "aws_subnet".
+type SweepGroup struct {
+	Name string
+	// Dependencies are the names of the groups that must be swept before this group, as for resource.Sweeper.
+	Dependencies []string
+	Sweepables   []Sweepable
+}
+
+// SweepReport describes the result of sweeping groups in dependency order.
+type SweepReport struct {
+	Waves   [][]string          // Group names in sweep order. The groups in each wave are swept concurrently.
+	Failed  map[string]error    // Groups that failed to sweep.
+	Blocked map[string][]string // Groups not swept, with the failed groups that blocked them.
+}
+
+func (r *SweepReport) String() string {
+	var b strings.Builder
+
+	for i, wave := range r.Waves {
+		fmt.Fprintf(&b, "wave %d: %s\n", i+1, strings.Join(wave, ", "))
+	}
+
+	for _, name := range sortedKeys(r.Failed) {
+		fmt.Fprintf(&b, "%s failed: %s\n", name, r.Failed[name])
+	}
+
+	for _, name := range sortedKeys(r.Blocked) {
+		fmt.Fprintf(&b, "%s blocked by: %s\n", name, strings.Join(r.Blocked[name], ", "))
+	}
+
+	return b.String()
+}
+
+// SweepOrchestratorWithDependencies sweeps groups of resources in waves so that each group is swept after its dependencies.
+// A group is not swept if any of its dependencies, directly or transitively, failed to sweep.
+// Dependencies on groups that are not present are ignored.
+func SweepOrchestratorWithDependencies(ctx context.Context, groups []SweepGroup, optFns ...tfresource.OptionsFunc) (*SweepReport, error) {
+	g := depgraph.New()
+	sweepables := make(map[string][]Sweepable)
+
+	for _, group := range groups {
+		g.AddNode(group.Name)
+		sweepables[group.Name] = append(sweepables[group.Name], group.Sweepables...)
+	}
+
+	for _, group := range groups {
+		for _, dependency := range group.Dependencies {
+			if !g.HasNode(dependency) {
+				continue
+			}
+
+			if err := g.AddDependency(group.Name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	waves, err := g.Waves()
+
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SweepReport{
+		Waves:   waves,
+		Failed:  make(map[string]error),
+		Blocked: make(map[string][]string),
+	}
+	var errs *multierror.Error
+
+	for _, wave := range waves {
+		var names []string
+
+		for _, name := range wave {
+			dependencies, err := g.DirectDependenciesOf(name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			var blockers []string
+			for _, dependency := range dependencies {
+				if _, ok := report.Failed[dependency]; ok {
+					blockers = append(blockers, dependency)
+				}
+				blockers = append(blockers, report.Blocked[dependency]...)
+			}
+
+			if len(blockers) > 0 {
+				slices.Sort(blockers)
+				report.Blocked[name] = slices.Compact(blockers)
+				log.Printf("[WARN] Skipping %s sweep: blocked by %s", name, strings.Join(report.Blocked[name], ", "))
+
+				continue
+			}
+
+			names = append(names, name)
+		}
+
+		results := make([]error, len(names))
+		var wg sync.WaitGroup
+
+		for i, name := range names {
+			i, name := i, name
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				results[i] = SweepOrchestratorWithContext(ctx, sweepables[name], optFns...)
+			}()
+		}
+
+		wg.Wait()
+
+		for i, name := range names {
+			if err := results[i]; err != nil {
+				report.Failed[name] = err
+				errs = multierror.Append(errs, fmt.Errorf("sweeping %s: %w", name, err))
+			}
+		}
+	}
+
+	return report, errs.ErrorOrNil()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := maps.Keys(m)
+	slices.Sort(keys)
+
+	return keys
+}
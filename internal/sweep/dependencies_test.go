@@ -0,0 +1,167 @@
+package sweep
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type mockSweepable struct {
+	err   error
+	name  string
+	order *[]string
+	lock  *sync.Mutex
+}
+
+func (m mockSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	*m.order = append(*m.order, m.name)
+
+	return m.err
+}
+
+func TestSweepOrchestratorWithDependencies(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		groups          []string
+		dependencies    map[string][]string
+		failures        []string
+		expectedOrder   []string
+		expectedWaves   [][]string
+		expectedBlocked map[string][]string
+		expectError     bool
+	}{
+		{
+			name:   "no dependencies",
+			groups: []string{"aws_subnet", "aws_vpc"},
+			expectedWaves: [][]string{
+				{"aws_subnet", "aws_vpc"},
+			},
+			expectedBlocked: map[string][]string{},
+		},
+		{
+			name:   "dependencies",
+			groups: []string{"aws_vpc", "aws_subnet", "aws_instance"},
+			dependencies: map[string][]string{
+				"aws_vpc":    {"aws_subnet"},
+				"aws_subnet": {"aws_instance"},
+			},
+			expectedOrder: []string{"aws_instance", "aws_subnet", "aws_vpc"},
+			expectedWaves: [][]string{
+				{"aws_instance"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+			expectedBlocked: map[string][]string{},
+		},
+		{
+			name:   "missing dependency",
+			groups: []string{"aws_vpc"},
+			dependencies: map[string][]string{
+				"aws_vpc": {"aws_subnet"},
+			},
+			expectedOrder: []string{"aws_vpc"},
+			expectedWaves: [][]string{
+				{"aws_vpc"},
+			},
+			expectedBlocked: map[string][]string{},
+		},
+		{
+			name:   "blocked",
+			groups: []string{"aws_vpc", "aws_subnet", "aws_instance", "aws_security_group"},
+			dependencies: map[string][]string{
+				"aws_vpc":    {"aws_subnet", "aws_security_group"},
+				"aws_subnet": {"aws_instance"},
+			},
+			failures: []string{"aws_instance"},
+			expectedWaves: [][]string{
+				{"aws_instance", "aws_security_group"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+			expectedBlocked: map[string][]string{
+				"aws_subnet": {"aws_instance"},
+				"aws_vpc":    {"aws_instance"},
+			},
+			expectError: true,
+		},
+		{
+			name:   "dependency cycle",
+			groups: []string{"aws_vpc", "aws_subnet"},
+			dependencies: map[string][]string{
+				"aws_vpc":    {"aws_subnet"},
+				"aws_subnet": {"aws_vpc"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var lock sync.Mutex
+			var order []string
+			var groups []SweepGroup
+
+			for _, name := range testCase.groups {
+				sweepable := mockSweepable{
+					lock:  &lock,
+					name:  name,
+					order: &order,
+				}
+				for _, v := range testCase.failures {
+					if v == name {
+						sweepable.err = errors.New("failed")
+					}
+				}
+
+				groups = append(groups, SweepGroup{
+					Name:         name,
+					Dependencies: testCase.dependencies[name],
+					Sweepables:   []Sweepable{sweepable},
+				})
+			}
+
+			report, err := SweepOrchestratorWithDependencies(context.Background(), groups)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("err = %v, want error %t", err, want)
+			}
+
+			if report == nil {
+				return
+			}
+
+			if testCase.expectedOrder != nil {
+				if diff := cmp.Diff(order, testCase.expectedOrder); diff != "" {
+					t.Errorf("unexpected order diff (+wanted, -got): %s", diff)
+				}
+			}
+			if diff := cmp.Diff(report.Waves, testCase.expectedWaves); diff != "" {
+				t.Errorf("unexpected waves diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(report.Blocked, testCase.expectedBlocked); diff != "" {
+				t.Errorf("unexpected blocked diff (+wanted, -got): %s", diff)
+			}
+			if got, want := len(report.Failed), len(testCase.failures); got != want {
+				t.Errorf("length of failed = %d, want %d", got, want)
+			}
+			for _, name := range order {
+				if _, ok := report.Blocked[name]; ok {
+					t.Errorf("blocked group %s was swept", name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// CreationTimeAttributes are the names of resource attributes that hold the resource's creation time.
+var CreationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"launch_time",
+}
+
+// TagsAttributes are the names of resource attributes that hold the resource's tags, in order of preference.
+var TagsAttributes = []string{
+	"tags_all",
+	"tags",
+}
+
+// Filter determines whether a sweeper skips a resource based on the resource's age and tags.
+type Filter struct {
+	MinAge   time.Duration // Resources created less than MinAge ago are skipped.
+	TagKey   string        // Resources without this tag are skipped.
+	TagValue string        // If set, resources whose TagKey tag has a different value are skipped.
+}
+
+// New returns a Filter for the specified minimum age (e.g. "6h") and ownership tag ("key" or "key=value").
+// Returns nil if neither is set.
+func New(minAge, tag string) (*Filter, error) {
+	if minAge == "" && tag == "" {
+		return nil, nil
+	}
+
+	f := &Filter{}
+
+	if minAge != "" {
+		d, err := time.ParseDuration(minAge)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing sweeper minimum age (%s): %w", minAge, err)
+		}
+
+		f.MinAge = d
+	}
+
+	if tag != "" {
+		key, value, _ := strings.Cut(tag, "=")
+
+		if key == "" {
+			return nil, fmt.Errorf("parsing sweeper ownership tag (%s): empty key", tag)
+		}
+
+		f.TagKey, f.TagValue = key, value
+	}
+
+	return f, nil
+}
+
+// FromEnv returns a Filter configured from environment variables, or nil if no filtering is configured.
+func FromEnv() (*Filter, error) {
+	return New(os.Getenv(envvar.SweepMinAge), os.Getenv(envvar.SweepOwnershipTag))
+}
+
+// Skip returns the reason that a resource with the specified creation time and tags is skipped, or "" if it is swept.
+// A zero creation time means that the resource's age is unknown. Such resources may have just been created and are skipped if MinAge is set.
+func (f *Filter) Skip(created time.Time, tags map[string]string) string {
+	if f.TagKey != "" {
+		v, ok := tags[f.TagKey]
+
+		if !ok {
+			return fmt.Sprintf("no %q tag", f.TagKey)
+		}
+
+		if f.TagValue != "" && v != f.TagValue {
+			return fmt.Sprintf("%q tag value %q does not match %q", f.TagKey, v, f.TagValue)
+		}
+	}
+
+	if f.MinAge > 0 {
+		if created.IsZero() {
+			return "no creation time"
+		}
+
+		if age := time.Since(created); age < f.MinAge {
+			return fmt.Sprintf("created %s ago, less than %s", age.Round(time.Minute), f.MinAge)
+		}
+	}
+
+	return ""
+}
+
+// NewTagsContext returns a Context in which a resource's Read handler records the resource's tags.
+// Resources that implement transparent tagging do not set their tags attributes themselves.
+func NewTagsContext(ctx context.Context, meta *conns.AWSClient) context.Context {
+	return tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig, meta.NormalizeTagsConfig)
+}
+
+// TransparentTags returns the tags of a resource that implements transparent tagging (@Tags annotation),
+// as read by the resource's Read handler into a Context returned by NewTagsContext or, if the Read handler
+// does not return tags, as listed by the service package's tagging support.
+// ok is false if the resource's tags are not available.
+func TransparentTags(ctx context.Context, meta *conns.AWSClient, sp conns.ServicePackage, tags *types.ServicePackageResourceTags, identifier string) (map[string]string, bool, error) {
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil, false, nil
+	}
+
+	if tagsInContext.TagsOut.IsNone() {
+		if sp == nil || tags == nil || identifier == "" {
+			return nil, false, nil
+		}
+
+		var err error
+		switch v := meta.TagsServicePackage(sp, identifier).(type) {
+		case interface {
+			ListTags(context.Context, any, string) error
+		}:
+			err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
+		case interface {
+			ListTags(context.Context, any, string, string) error
+		}:
+			if tags.ResourceType == "" {
+				return nil, false, nil
+			}
+			err = v.ListTags(ctx, meta, identifier, tags.ResourceType) // Sets tags in Context
+		default:
+			return nil, false, nil
+		}
+
+		if err != nil {
+			return nil, false, fmt.Errorf("listing tags for %s: %w", identifier, err)
+		}
+	}
+
+	apiTags := tagsInContext.TagsOut.UnwrapOrDefault()
+	if sp != nil {
+		apiTags = apiTags.IgnoreSystem(sp.ServicePackageName())
+	}
+
+	return apiTags.Map(), true, nil
+}
+
+// ParseCreationTime parses a creation time attribute value.
+// Returns the zero time if the value cannot be parsed.
+func ParseCreationTime(s string) time.Time {
+	for _, layout := range []string{time.RFC3339Nano, time.RFC3339} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
@@ -0,0 +1,185 @@
+package filter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		minAge      string
+		tag         string
+		expected    *Filter
+		expectError bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "min age",
+			minAge:   "6h",
+			expected: &Filter{MinAge: 6 * time.Hour},
+		},
+		{
+			name:        "invalid min age",
+			minAge:      "6",
+			expectError: true,
+		},
+		{
+			name:     "tag key",
+			tag:      "Owner",
+			expected: &Filter{TagKey: "Owner"},
+		},
+		{
+			name:     "tag key and value",
+			tag:      "Owner=ci-1",
+			expected: &Filter{TagKey: "Owner", TagValue: "ci-1"},
+		},
+		{
+			name:        "empty tag key",
+			tag:         "=ci-1",
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := New(testCase.minAge, testCase.tag)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+
+			switch {
+			case got == nil && testCase.expected == nil:
+			case got == nil || testCase.expected == nil:
+				t.Errorf("New() = %v, want %v", got, testCase.expected)
+			case *got != *testCase.expected:
+				t.Errorf("New() = %v, want %v", *got, *testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFilterSkip(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testCases := []struct {
+		name     string
+		filter   *Filter
+		created  time.Time
+		tags     map[string]string
+		expected bool
+	}{
+		{
+			name:    "old enough",
+			filter:  &Filter{MinAge: time.Hour},
+			created: now.Add(-2 * time.Hour),
+		},
+		{
+			name:     "too young",
+			filter:   &Filter{MinAge: time.Hour},
+			created:  now.Add(-30 * time.Minute),
+			expected: true,
+		},
+		{
+			name:     "unknown age",
+			filter:   &Filter{MinAge: time.Hour},
+			expected: true,
+		},
+		{
+			name:   "unknown age no minimum",
+			filter: &Filter{TagKey: "Owner"},
+			tags:   map[string]string{"Owner": "ci-1"},
+		},
+		{
+			name:   "has tag",
+			filter: &Filter{TagKey: "Owner"},
+			tags:   map[string]string{"Owner": "ci-1"},
+		},
+		{
+			name:     "missing tag",
+			filter:   &Filter{TagKey: "Owner"},
+			tags:     map[string]string{"Name": "tf-acc-test"},
+			expected: true,
+		},
+		{
+			name:   "matching tag value",
+			filter: &Filter{TagKey: "Owner", TagValue: "ci-1"},
+			tags:   map[string]string{"Owner": "ci-1"},
+		},
+		{
+			name:     "different tag value",
+			filter:   &Filter{TagKey: "Owner", TagValue: "ci-1"},
+			tags:     map[string]string{"Owner": "ci-2"},
+			expected: true,
+		},
+		{
+			name:     "has tag too young",
+			filter:   &Filter{MinAge: time.Hour, TagKey: "Owner"},
+			created:  now,
+			tags:     map[string]string{"Owner": "ci-1"},
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			reason := testCase.filter.Skip(testCase.created, testCase.tags)
+
+			if got, want := reason != "", testCase.expected; got != want {
+				t.Errorf("Skip() = %q, want skipped %t", reason, want)
+			}
+		})
+	}
+}
+
+func TestParseCreationTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		value    string
+		expected time.Time
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "RFC3339",
+			value:    "2023-06-01T12:00:00Z",
+			expected: time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "RFC3339 fractional seconds",
+			value:    "2023-06-01T12:00:00.5Z",
+			expected: time.Date(2023, 6, 1, 12, 0, 0, 500000000, time.UTC),
+		},
+		{
+			name:  "invalid",
+			value: "yesterday",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ParseCreationTime(testCase.value), testCase.expected; !got.Equal(want) {
+				t.Errorf("ParseCreationTime(%q) = %s, want %s", testCase.value, got, want)
+			}
+		})
+	}
+}
@@ -3,17 +3,22 @@ package framework
 import (
 	"context"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	awstypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 type attribute struct {
@@ -43,31 +48,28 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 }
 
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
+	resource, state, err := sr.newState(ctx)
 
 	if err != nil {
 		return err
 	}
 
-	metadata := resourceMetadata(ctx, resource)
-	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
-
-	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+	ctx = tflog.SetField(ctx, "resource_type", resourceMetadata(ctx, resource).TypeName)
+	for _, attr := range sr.attributes {
+		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	}
 
-	schemaResp := fwresource.SchemaResponse{}
-	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	state, reason, err := sr.skip(ctx, resource, state)
 
-	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
+	if err != nil {
+		return err
 	}
 
-	for _, attr := range sr.attributes {
-		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
-		}
-		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	if reason != "" {
+		tflog.Info(ctx, "Skipping resource", map[string]any{
+			"reason": reason,
+		})
+		return nil
 	}
 
 	tflog.Info(ctx, "Sweeping resource")
@@ -96,6 +98,188 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// InventoryItem describes the resource that would be deleted and the reason that any configured sweeper filter skips it.
+func (sr *sweepResource) InventoryItem(ctx context.Context) (inventory.Item, error) {
+	item := inventory.Item{
+		Region: sr.meta.Region,
+	}
+
+	for _, attr := range sr.attributes {
+		v, ok := attr.value.(string)
+		if !ok {
+			continue
+		}
+
+		switch attr.path {
+		case "id":
+			item.ID = v
+		case "name":
+			item.Name = v
+		}
+	}
+
+	resource, state, err := sr.newState(ctx)
+
+	if err != nil {
+		return item, err
+	}
+
+	item.Type = resourceMetadata(ctx, resource).TypeName
+
+	_, item.SkipReason, err = sr.skip(ctx, resource, state)
+
+	return item, err
+}
+
+// newState returns a new configured instance of the resource and its state, populated from the sweeper attributes.
+func (sr *sweepResource) newState(ctx context.Context) (fwresource.ResourceWithConfigure, tfsdk.State, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return nil, tfsdk.State{}, err
+	}
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+
+	schemaResp := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+
+	for _, attr := range sr.attributes {
+		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
+		if d.HasError() {
+			return nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
+		}
+	}
+
+	return resource, state, nil
+}
+
+// skip applies any configured sweeper filter to the resource, returning the refreshed state
+// and the reason that the resource is skipped, or "" if it is swept.
+func (sr *sweepResource) skip(ctx context.Context, resource fwresource.Resource, state tfsdk.State) (tfsdk.State, string, error) {
+	f, err := filter.FromEnv()
+
+	if f == nil || err != nil {
+		return state, "", err
+	}
+
+	return skip(ctx, f, state, resource, sr.meta, resourceMetadata(ctx, resource).TypeName)
+}
+
+// skip reads the resource to determine its creation time and tags and returns the refreshed state
+// and the reason that the resource is skipped by the sweeper filter, or "" if it is swept.
+func skip(ctx context.Context, f *filter.Filter, state tfsdk.State, resource fwresource.Resource, meta *conns.AWSClient, typeName string) (tfsdk.State, string, error) {
+	// Resources that implement transparent tagging record their tags in Context.
+	ctx = filter.NewTagsContext(ctx, meta)
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if response.Diagnostics.HasError() {
+		return state, "", fwdiag.DiagnosticsError(response.Diagnostics)
+	}
+
+	state = response.State
+
+	if state.Raw.IsNull() {
+		return state, "resource not found", nil
+	}
+
+	var created time.Time
+	for _, k := range filter.CreationTimeAttributes {
+		if _, ok := state.Schema.GetAttributes()[k]; !ok {
+			continue
+		}
+
+		var v types.String
+		if d := state.GetAttribute(ctx, path.Root(k), &v); d.HasError() {
+			continue
+		}
+
+		if created = filter.ParseCreationTime(v.ValueString()); !created.IsZero() {
+			break
+		}
+	}
+
+	info := lookupResource(ctx, meta, typeName)
+
+	var identifier string
+	if info.tags != nil && info.tags.IdentifierAttribute != "" {
+		var v types.String
+		if d := state.GetAttribute(ctx, path.Root(info.tags.IdentifierAttribute), &v); !d.HasError() {
+			identifier = v.ValueString()
+		}
+	}
+
+	tags, ok, err := filter.TransparentTags(ctx, meta, info.servicePackage, info.tags, identifier)
+
+	if err != nil {
+		return state, "", err
+	}
+
+	if ok {
+		return state, f.Skip(created, tags), nil
+	}
+
+	tags = make(map[string]string)
+	for _, k := range filter.TagsAttributes {
+		if _, ok := state.Schema.GetAttributes()[k]; !ok {
+			continue
+		}
+
+		var v types.Map
+		if d := state.GetAttribute(ctx, path.Root(k), &v); d.HasError() || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if d := v.ElementsAs(ctx, &tags, false); !d.HasError() {
+			break
+		}
+	}
+
+	return state, f.Skip(created, tags), nil
+}
+
+// resourceInfo describes a registered resource.
+type resourceInfo struct {
+	servicePackage conns.ServicePackage
+	tags           *awstypes.ServicePackageResourceTags
+}
+
+var (
+	resourceInfosOnce sync.Once
+	resourceInfos     map[string]resourceInfo
+)
+
+// lookupResource returns the description of the resource with the specified type name, looked up from the registered service packages.
+func lookupResource(ctx context.Context, meta *conns.AWSClient, typeName string) resourceInfo {
+	resourceInfosOnce.Do(func() {
+		resourceInfos = make(map[string]resourceInfo)
+
+		for _, sp := range meta.ServicePackages {
+			for _, v := range sp.FrameworkResources(ctx) {
+				resource, err := v.Factory(ctx)
+
+				if err != nil {
+					continue
+				}
+
+				resourceInfos[resourceMetadata(ctx, resource).TypeName] = resourceInfo{
+					servicePackage: sp,
+					tags:           v.Tags,
+				}
+			}
+		}
+	})
+
+	return resourceInfos[typeName]
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
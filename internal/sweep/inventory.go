@@ -0,0 +1,93 @@
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
+)
+
+// inventoryNamePrefixes are the name prefixes used by acceptance test resources.
+var inventoryNamePrefixes = []string{
+	ResourcePrefix,
+	"tf_acc_test",
+	"tfacctest",
+	"tf-test",
+	"terraform-",
+}
+
+type inventoriable interface {
+	InventoryItem(context.Context) (inventory.Item, error)
+}
+
+// inventoryLock serializes inventory writes from concurrent sweepers.
+var inventoryLock sync.Mutex
+
+// DryRun returns whether sweepers are writing an inventory instead of deleting resources.
+func DryRun() bool {
+	return os.Getenv(envvar.SweepDryRun) != ""
+}
+
+// writeInventory writes an inventory of sweepables to the configured destination.
+func writeInventory(ctx context.Context, sweepables []Sweepable) error {
+	inventoryLock.Lock()
+	defer inventoryLock.Unlock()
+
+	if filename := os.Getenv(envvar.SweepInventoryFile); filename != "" {
+		f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+		if err != nil {
+			return fmt.Errorf("opening sweeper inventory file (%s): %w", filename, err)
+		}
+
+		defer f.Close()
+
+		return encodeInventory(ctx, f, sweepables)
+	}
+
+	return encodeInventory(ctx, os.Stdout, sweepables)
+}
+
+// encodeInventory writes an inventory of sweepables as JSON Lines.
+func encodeInventory(ctx context.Context, w io.Writer, sweepables []Sweepable) error {
+	enc := json.NewEncoder(w)
+
+	for _, sweepable := range sweepables {
+		var item inventory.Item
+
+		if v, ok := sweepable.(inventoriable); ok {
+			var err error
+			item, err = v.InventoryItem(ctx)
+
+			if err != nil {
+				return fmt.Errorf("describing sweeper inventory item: %w", err)
+			}
+		} else {
+			item.Type = fmt.Sprintf("%T", sweepable)
+		}
+
+		item.NamePrefix = inventoryNamePrefix(item)
+
+		if err := enc.Encode(item); err != nil {
+			return fmt.Errorf("writing sweeper inventory: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func inventoryNamePrefix(item inventory.Item) string {
+	for _, prefix := range inventoryNamePrefixes {
+		if strings.HasPrefix(item.Name, prefix) || strings.HasPrefix(item.ID, prefix) {
+			return prefix
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,11 @@
+package inventory
+
+// Item describes a resource that a sweeper would delete.
+type Item struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	Region     string `json:"region"`
+	Name       string `json:"name,omitempty"`
+	NamePrefix string `json:"name_prefix,omitempty"` // The acceptance test name prefix that Name or ID matches.
+	SkipReason string `json:"skip_reason,omitempty"` // The reason that the resource is skipped by the sweeper filter, if any.
+}
@@ -0,0 +1,130 @@
+package sweep
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type mockInventoriable struct {
+	item inventory.Item
+	err  error
+}
+
+func (m mockInventoriable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	return errors.New("unexpected Delete")
+}
+
+func (m mockInventoriable) InventoryItem(ctx context.Context) (inventory.Item, error) {
+	return m.item, m.err
+}
+
+func TestEncodeInventory(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		sweepables []Sweepable
+		expected   string
+	}{
+		{
+			name:     "empty",
+			expected: "",
+		},
+		{
+			name: "name prefix",
+			sweepables: []Sweepable{
+				mockInventoriable{item: inventory.Item{Type: "aws_vpc", ID: "vpc-12345678", Region: "us-west-2", Name: "tf-acc-test-1234"}},
+			},
+			expected: `{"type":"aws_vpc","id":"vpc-12345678","region":"us-west-2","name":"tf-acc-test-1234","name_prefix":"tf-acc-test"}` + "\n",
+		},
+		{
+			name: "ID prefix",
+			sweepables: []Sweepable{
+				mockInventoriable{item: inventory.Item{Type: "aws_sqs_queue", ID: "tf_acc_test_1234", Region: "us-east-1"}},
+			},
+			expected: `{"type":"aws_sqs_queue","id":"tf_acc_test_1234","region":"us-east-1","name_prefix":"tf_acc_test"}` + "\n",
+		},
+		{
+			name: "no prefix",
+			sweepables: []Sweepable{
+				mockInventoriable{item: inventory.Item{Type: "aws_vpc", ID: "vpc-12345678", Region: "us-west-2", Name: "production"}},
+				mockInventoriable{item: inventory.Item{Type: "aws_subnet", ID: "subnet-12345678", Region: "us-west-2"}},
+			},
+			expected: `{"type":"aws_vpc","id":"vpc-12345678","region":"us-west-2","name":"production"}` + "\n" +
+				`{"type":"aws_subnet","id":"subnet-12345678","region":"us-west-2"}` + "\n",
+		},
+		{
+			name: "skipped",
+			sweepables: []Sweepable{
+				mockInventoriable{item: inventory.Item{Type: "aws_vpc", ID: "vpc-12345678", Region: "us-west-2", Name: "tf-acc-test-1234", SkipReason: `no "Owner" tag`}},
+			},
+			expected: `{"type":"aws_vpc","id":"vpc-12345678","region":"us-west-2","name":"tf-acc-test-1234","name_prefix":"tf-acc-test","skip_reason":"no \"Owner\" tag"}` + "\n",
+		},
+		{
+			name: "not inventoriable",
+			sweepables: []Sweepable{
+				mockSweepable{},
+			},
+			expected: `{"type":"sweep.mockSweepable","id":"","region":""}` + "\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b bytes.Buffer
+
+			if err := encodeInventory(context.Background(), &b, testCase.sweepables); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := b.String(), testCase.expected; got != want {
+				t.Errorf("inventory = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestEncodeInventory_error(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+	sweepables := []Sweepable{
+		mockInventoriable{err: errors.New("reading resource")},
+	}
+
+	if err := encodeInventory(context.Background(), &b, sweepables); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestNewSweeperConfig_dryRun(t *testing.T) { //nolint:paralleltest
+	for _, testCase := range []struct {
+		dryRun   string
+		expected bool
+	}{
+		{"", false},
+		{"1", true},
+	} {
+		t.Setenv(envvar.SweepDryRun, testCase.dryRun)
+
+		conf, err := newSweeperConfig("us-west-2") //lintignore:AWSAT003
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, want := conf.ReadOnlyAPI, testCase.expected; got != want {
+			t.Errorf("dry run %q: ReadOnlyAPI = %t, want %t", testCase.dryRun, got, want)
+		}
+	}
+}
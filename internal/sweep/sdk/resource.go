@@ -2,7 +2,9 @@ package sdk
 
 import (
 	"context"
+	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -11,7 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 type sweepResource struct {
@@ -31,6 +36,15 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
+	if reason, err := sr.skip(ctx); err != nil {
+		return err
+	} else if reason != "" {
+		tflog.Info(ctx, "Skipping resource", map[string]any{
+			"reason": reason,
+		})
+		return nil
+	}
+
 	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
 		err := deleteResource(ctx, sr.resource, sr.d, sr.meta)
 
@@ -55,6 +69,154 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// skip returns the reason that the resource is skipped by any configured sweeper filter, or "" if it is swept.
+// The resource is read to determine its creation time and tags.
+func (sr *sweepResource) skip(ctx context.Context) (string, error) {
+	f, err := filter.FromEnv()
+
+	if f == nil || err != nil {
+		return "", err
+	}
+
+	// Resources that implement transparent tagging record their tags in Context.
+	ctx = filter.NewTagsContext(ctx, sr.meta)
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return "", err
+	}
+
+	if sr.d.Id() == "" {
+		return "resource not found", nil
+	}
+
+	var created time.Time
+	for _, k := range filter.CreationTimeAttributes {
+		if _, ok := sr.resource.Schema[k]; !ok {
+			continue
+		}
+
+		if v, ok := sr.d.Get(k).(string); ok {
+			if created = filter.ParseCreationTime(v); !created.IsZero() {
+				break
+			}
+		}
+	}
+
+	info := lookupResource(ctx, sr.resource, sr.meta)
+
+	var identifier string
+	if info.tags != nil {
+		if k := info.tags.IdentifierAttribute; k == "id" {
+			identifier = sr.d.Id()
+		} else if k != "" {
+			identifier, _ = sr.d.Get(k).(string)
+		}
+	}
+
+	tags, ok, err := filter.TransparentTags(ctx, sr.meta, info.servicePackage, info.tags, identifier)
+
+	if err != nil {
+		return "", err
+	}
+
+	if ok {
+		return f.Skip(created, tags), nil
+	}
+
+	tags = make(map[string]string)
+	for _, k := range filter.TagsAttributes {
+		if _, ok := sr.resource.Schema[k]; !ok {
+			continue
+		}
+
+		if v, ok := sr.d.Get(k).(map[string]any); ok {
+			for k, v := range v {
+				tags[k], _ = v.(string)
+			}
+			break
+		}
+	}
+
+	return f.Skip(created, tags), nil
+}
+
+// InventoryItem describes the resource that would be deleted and the reason that any configured sweeper filter skips it.
+func (sr *sweepResource) InventoryItem(ctx context.Context) (inventory.Item, error) {
+	item := inventory.Item{
+		Type:   lookupResource(ctx, sr.resource, sr.meta).typeName,
+		ID:     sr.d.Id(),
+		Region: sr.meta.Region,
+	}
+
+	reason, err := sr.skip(ctx)
+
+	if err != nil {
+		return item, err
+	}
+
+	item.SkipReason = reason
+
+	if _, ok := sr.resource.Schema["name"]; ok {
+		if v, ok := sr.d.Get("name").(string); ok {
+			item.Name = v
+		}
+	}
+
+	return item, nil
+}
+
+// resourceInfo describes a registered resource.
+type resourceInfo struct {
+	typeName       string
+	servicePackage conns.ServicePackage
+	tags           *types.ServicePackageResourceTags
+}
+
+var (
+	resourceInfosOnce sync.Once
+	resourceInfos     map[uintptr]resourceInfo
+)
+
+// lookupResource returns the description of the specified resource, looked up from the registered service packages.
+// Resources are identified by their Delete handler.
+func lookupResource(ctx context.Context, resource *schema.Resource, meta *conns.AWSClient) resourceInfo {
+	resourceInfosOnce.Do(func() {
+		resourceInfos = make(map[uintptr]resourceInfo)
+
+		for _, sp := range meta.ServicePackages {
+			for _, v := range sp.SDKResources(ctx) {
+				if key := deleteHandler(v.Factory()); key != 0 {
+					if _, ok := resourceInfos[key]; ok {
+						// Shared handlers are ambiguous.
+						resourceInfos[key] = resourceInfo{}
+					} else {
+						resourceInfos[key] = resourceInfo{
+							typeName:       v.TypeName,
+							servicePackage: sp,
+							tags:           v.Tags,
+						}
+					}
+				}
+			}
+		}
+	})
+
+	return resourceInfos[deleteHandler(resource)]
+}
+
+func deleteHandler(resource *schema.Resource) uintptr {
+	switch {
+	case resource.DeleteWithoutTimeout != nil:
+		return reflect.ValueOf(resource.DeleteWithoutTimeout).Pointer()
+	case resource.DeleteContext != nil:
+		return reflect.ValueOf(resource.DeleteContext).Pointer()
+	case resource.Delete != nil:
+		return reflect.ValueOf(resource.Delete).Pointer()
+	}
+
+	return 0
+}
+
 func deleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -58,14 +59,38 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 	meta.ServicePackages = servicePackageMap
 
+	conf, err := newSweeperConfig(region)
+	if err != nil {
+		return nil, err
+	}
+
+	// configures a default client for the region, using the above env vars
+	client, diags := conf.ConfigureProvider(ctx, meta)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("getting AWS client: %#v", diags)
+	}
+
+	sweeperClients[region] = client
+
+	return client, nil
+}
+
+// newSweeperConfig returns the provider configuration used by sweepers in the specified Region.
+// In dry-run mode only read-only AWS API calls are allowed, so that sweepers which delete resources
+// directly instead of using SweepOrchestratorWithContext cannot delete anything.
+func newSweeperConfig(region string) (*conns.Config, error) {
 	conf := &conns.Config{
 		MaxRetries:       5,
+		ReadOnlyAPI:      DryRun(),
 		Region:           region,
 		SuppressDebugLog: true,
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		conf.AssumeRole = &awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
 		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
@@ -85,23 +110,20 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		}
 	}
 
-	// configures a default client for the region, using the above env vars
-	client, diags := conf.ConfigureProvider(ctx, meta)
-
-	if diags.HasError() {
-		return nil, fmt.Errorf("getting AWS client: %#v", diags)
-	}
-
-	sweeperClients[region] = client
-
-	return client, nil
+	return conf, nil
 }
 
 type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestratorWithContext deletes the sweepables concurrently.
+// In dry-run mode an inventory of the sweepables is written instead.
 func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	if DryRun() {
+		return writeInventory(ctx, sweepables)
+	}
+
 	var g multierror.Group
 
 	for _, sweepable := range sweepables {
@@ -0,0 +1,39 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+)
+
+// InitializeSemaphore returns a semaphore for limiting concurrent acceptance tests.
+// Its capacity is the default limit unless overridden using the specified environment variable.
+func InitializeSemaphore(envvar string, defaultLimit int64) *Semaphore {
+	limit := defaultLimit
+	x := os.Getenv(envvar)
+	if x != "" {
+		var err error
+		limit, err = strconv.ParseInt(x, 10, 64)
+		if err != nil {
+			panic(fmt.Errorf("could not parse %q: expected integer, got %q", envvar, x))
+		}
+	}
+	return NewSemaphore(envvar, limit)
+}
+
+// TestAccPreCheckSyncronize acquires the semaphore for the duration of the test.
+// The test is skipped if the semaphore has no capacity.
+func TestAccPreCheckSyncronize(t *testing.T, semaphore *Semaphore, resource string) {
+	if semaphore.Capacity() == 0 {
+		t.Skipf("concurrency for %s testing set to 0", resource)
+	}
+
+	release, err := semaphore.Acquire(WithHolderName(context.Background(), t.Name()), 1)
+	if err != nil {
+		t.Fatalf("acquiring semaphore for %s testing: %s", resource, err)
+	}
+
+	t.Cleanup(release)
+}
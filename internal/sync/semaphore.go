@@ -0,0 +1,397 @@
+package sync
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// diagnosticsInterval is how often a warning is logged while waiting to acquire a semaphore.
+	diagnosticsInterval = 1 * time.Minute
+)
+
+// Semaphore is a weighted semaphore limiting concurrent access to a shared resource, e.g. an AWS API with a low quota.
+// Each holder acquires a weight from the semaphore's total capacity.
+// Waiters are granted access in first-in, first-out order.
+type Semaphore struct {
+	capacity int64
+	name     string
+	timeout  time.Duration
+
+	holders map[uint64]*Holder
+	inUse   int64
+	lock    sync.Mutex
+	metrics Metrics
+	nextID  uint64
+	waiters list.List // Of *waiter.
+}
+
+// Holder represents a current holder of a semaphore.
+type Holder struct {
+	Acquired time.Time
+	Name     string // Describes what acquired the semaphore.
+	Weight   int64
+}
+
+func (h *Holder) String() string {
+	return fmt.Sprintf("%s (weight %d, held for %s)", h.Name, h.Weight, time.Since(h.Acquired).Round(time.Second))
+}
+
+// Metrics represents a semaphore's usage statistics.
+type Metrics struct {
+	Acquisitions int64         // Number of successful acquisitions.
+	Timeouts     int64         // Number of acquisitions that timed out or were canceled.
+	InUse        int64         // Weight currently held.
+	Waiting      int64         // Number of waiters.
+	MaxWait      time.Duration // Longest time spent waiting for a successful acquisition.
+	TotalWait    time.Duration // Total time spent waiting for successful acquisitions.
+}
+
+type waiter struct {
+	holder *Holder
+	id     uint64
+	ready  chan struct{} // Closed when the semaphore is acquired.
+	start  time.Time
+}
+
+// SemaphoreOptionsFunc configures a Semaphore.
+type SemaphoreOptionsFunc func(*Semaphore)
+
+// WithTimeout sets the maximum time that Acquire waits for the semaphore.
+// The Context passed to Acquire can further limit the time waited.
+func WithTimeout(timeout time.Duration) SemaphoreOptionsFunc {
+	return func(s *Semaphore) {
+		s.timeout = timeout
+	}
+}
+
+// NewSemaphore returns a new Semaphore with the specified name and total capacity.
+func NewSemaphore(name string, capacity int64, optFns ...SemaphoreOptionsFunc) *Semaphore {
+	s := &Semaphore{
+		capacity: capacity,
+		holders:  make(map[uint64]*Holder),
+		name:     name,
+	}
+
+	for _, fn := range optFns {
+		fn(s)
+	}
+
+	return s
+}
+
+// Name returns the semaphore's name.
+func (s *Semaphore) Name() string {
+	return s.name
+}
+
+// Capacity returns the semaphore's total capacity.
+func (s *Semaphore) Capacity() int64 {
+	return s.capacity
+}
+
+// Acquire acquires the semaphore with the specified weight, blocking until resources are available,
+// the semaphore's timeout elapses or the Context is done.
+// On success the returned function must be called to release the semaphore.
+// On failure a *TimeoutError describing the current holders is returned.
+func (s *Semaphore) Acquire(ctx context.Context, weight int64) (func(), error) {
+	if weight <= 0 {
+		return nil, fmt.Errorf("acquiring semaphore (%s): invalid weight %d", s.name, weight)
+	}
+
+	if weight > s.capacity {
+		// Waiting would block forever.
+		return nil, fmt.Errorf("acquiring semaphore (%s): weight %d exceeds capacity %d", s.name, weight, s.capacity)
+	}
+
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	holder := &Holder{
+		Name:   holderName(ctx),
+		Weight: weight,
+	}
+
+	s.lock.Lock()
+	w := s.newWaiter(holder, start)
+
+	if s.waiters.Len() == 0 && s.inUse+weight <= s.capacity {
+		s.grant(w)
+		s.lock.Unlock()
+
+		return s.releaseFunc(w.id), nil
+	}
+
+	element := s.waiters.PushBack(w)
+	s.lock.Unlock()
+
+	ticker := time.NewTicker(diagnosticsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ready:
+			return s.releaseFunc(w.id), nil
+
+		case <-ticker.C:
+			tflog.Warn(ctx, "Waiting for semaphore", map[string]any{
+				"tf_aws.semaphore.name":    s.name,
+				"tf_aws.semaphore.holder":  w.holder.Name,
+				"tf_aws.semaphore.waited":  time.Since(start).Round(time.Second).String(),
+				"tf_aws.semaphore.holders": holderNames(s.Holders()),
+			})
+
+		case <-ctx.Done():
+			s.lock.Lock()
+			select {
+			case <-w.ready:
+				// Acquired after the Context was done.
+				s.lock.Unlock()
+
+				return s.releaseFunc(w.id), nil
+			default:
+			}
+
+			isFront := s.waiters.Front() == element
+			s.waiters.Remove(element)
+			// If the front waiter was removed there may be capacity for the following waiters.
+			if isFront {
+				s.notifyWaiters()
+			}
+			s.metrics.Timeouts++
+			err := &TimeoutError{
+				Name:     s.name,
+				Capacity: s.capacity,
+				InUse:    s.inUse,
+				Weight:   weight,
+				Waited:   time.Since(start),
+				Holders:  s.holdersLocked(),
+				err:      ctx.Err(),
+			}
+			s.lock.Unlock()
+
+			return nil, err
+		}
+	}
+}
+
+// TryAcquire acquires the semaphore with the specified weight without blocking.
+// On success the returned function must be called to release the semaphore.
+func (s *Semaphore) TryAcquire(weight int64) (func(), bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if weight <= 0 || s.waiters.Len() > 0 || s.inUse+weight > s.capacity {
+		return nil, false
+	}
+
+	w := s.newWaiter(&Holder{Name: holderName(context.Background()), Weight: weight}, time.Now())
+	s.grant(w)
+
+	return s.releaseFunc(w.id), true
+}
+
+// Holders returns the semaphore's current holders.
+func (s *Semaphore) Holders() []Holder {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.holdersLocked()
+}
+
+// Metrics returns the semaphore's usage statistics.
+func (s *Semaphore) Metrics() Metrics {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	metrics := s.metrics
+	metrics.InUse = s.inUse
+	metrics.Waiting = int64(s.waiters.Len())
+
+	return metrics
+}
+
+// newWaiter returns a new waiter with a unique ID.
+// The caller must hold the lock.
+func (s *Semaphore) newWaiter(holder *Holder, start time.Time) *waiter {
+	w := &waiter{
+		holder: holder,
+		id:     s.nextID,
+		ready:  make(chan struct{}),
+		start:  start,
+	}
+	s.nextID++
+
+	return w
+}
+
+// grant acquires the semaphore for the waiter.
+// The caller must hold the lock.
+func (s *Semaphore) grant(w *waiter) {
+	now := time.Now()
+	wait := now.Sub(w.start)
+
+	w.holder.Acquired = now
+	s.holders[w.id] = w.holder
+	s.inUse += w.holder.Weight
+	s.metrics.Acquisitions++
+	s.metrics.TotalWait += wait
+	if wait > s.metrics.MaxWait {
+		s.metrics.MaxWait = wait
+	}
+
+	close(w.ready)
+}
+
+// notifyWaiters grants the semaphore to waiters in order while there is capacity.
+// The caller must hold the lock.
+func (s *Semaphore) notifyWaiters() {
+	for {
+		element := s.waiters.Front()
+		if element == nil {
+			break
+		}
+
+		w := element.Value.(*waiter)
+		if s.inUse+w.holder.Weight > s.capacity {
+			// Don't let smaller waiters starve the front waiter.
+			break
+		}
+
+		s.waiters.Remove(element)
+		s.grant(w)
+	}
+}
+
+func (s *Semaphore) releaseFunc(id uint64) func() {
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+
+			if h, ok := s.holders[id]; ok {
+				s.inUse -= h.Weight
+				delete(s.holders, id)
+			}
+
+			s.notifyWaiters()
+		})
+	}
+}
+
+// holdersLocked returns the semaphore's current holders, longest held first.
+// The caller must hold the lock.
+func (s *Semaphore) holdersLocked() []Holder {
+	holders := make([]Holder, 0, len(s.holders))
+
+	for _, h := range s.holders {
+		holders = append(holders, *h)
+	}
+
+	sort.Slice(holders, func(i, j int) bool {
+		return holders[i].Acquired.Before(holders[j].Acquired)
+	})
+
+	return holders
+}
+
+// TimeoutError is returned when a semaphore cannot be acquired in time.
+// It includes details of the semaphore's holders to help diagnose deadlocks.
+type TimeoutError struct {
+	Name     string
+	Capacity int64
+	InUse    int64
+	Weight   int64
+	Waited   time.Duration
+	Holders  []Holder
+
+	err error
+}
+
+func (e *TimeoutError) Error() string {
+	holders := holderNames(e.Holders)
+	if len(holders) == 0 {
+		holders = append(holders, "<none>")
+	}
+
+	return fmt.Sprintf("timeout after %s acquiring semaphore (%s) with weight %d (%d of %d in use), held by: %s: %s",
+		e.Waited.Round(time.Millisecond), e.Name, e.Weight, e.InUse, e.Capacity, strings.Join(holders, ", "), e.err)
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.err
+}
+
+// IsTimeoutError returns true if the error is a semaphore *TimeoutError.
+func IsTimeoutError(err error) bool {
+	var e *TimeoutError
+	return errors.As(err, &e)
+}
+
+func holderNames(holders []Holder) []string {
+	names := make([]string, len(holders))
+
+	for i, h := range holders {
+		names[i] = h.String()
+	}
+
+	return names
+}
+
+type contextKeyType int
+
+var (
+	holderNameContextKey contextKeyType
+)
+
+// WithHolderName returns a Context that describes the holder of any semaphore acquired with it, e.g. "aws_vpc.main Create".
+// The description is included in diagnostics.
+// By default the function acquiring the semaphore describes the holder.
+func WithHolderName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, holderNameContextKey, name)
+}
+
+func holderName(ctx context.Context) string {
+	if v, ok := ctx.Value(holderNameContextKey).(string); ok && v != "" {
+		return v
+	}
+
+	return caller()
+}
+
+// caller returns the name of the function calling the Semaphore's public API.
+func caller() string {
+	pcs := make([]uintptr, 8)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+
+		// Skip this package's frames.
+		if !strings.Contains(frame.Function, "internal/sync.") {
+			return fmt.Sprintf("%s (%s:%d)", frame.Function, frame.File, frame.Line)
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return "<unknown>"
+}
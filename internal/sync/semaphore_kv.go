@@ -0,0 +1,51 @@
+package sync
+
+import (
+	"sync"
+)
+
+// GlobalSemaphoreKV is a global SemaphoreKV for use within this plugin.
+var GlobalSemaphoreKV = NewSemaphoreKV()
+
+// SemaphoreKV is a simple key/value store for named semaphores.
+// It can be used to limit concurrency across arbitrary collaborators that share knowledge of the keys.
+type SemaphoreKV struct {
+	lock  sync.Mutex
+	store map[string]*Semaphore
+}
+
+// NewSemaphoreKV returns a properly initialized SemaphoreKV.
+func NewSemaphoreKV() *SemaphoreKV {
+	return &SemaphoreKV{
+		store: make(map[string]*Semaphore),
+	}
+}
+
+// Get returns the semaphore for the given key.
+// If there is no such semaphore one is created with the specified capacity and options.
+func (kv *SemaphoreKV) Get(key string, capacity int64, optFns ...SemaphoreOptionsFunc) *Semaphore {
+	kv.lock.Lock()
+	defer kv.lock.Unlock()
+
+	s, ok := kv.store[key]
+	if !ok {
+		s = NewSemaphore(key, capacity, optFns...)
+		kv.store[key] = s
+	}
+
+	return s
+}
+
+// Metrics returns usage statistics for all semaphores, keyed by name.
+func (kv *SemaphoreKV) Metrics() map[string]Metrics {
+	kv.lock.Lock()
+	defer kv.lock.Unlock()
+
+	metrics := make(map[string]Metrics, len(kv.store))
+
+	for k, s := range kv.store {
+		metrics[k] = s.Metrics()
+	}
+
+	return metrics
+}
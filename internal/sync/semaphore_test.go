@@ -0,0 +1,224 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSemaphoreAcquire(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := NewSemaphore("test", 2)
+
+	release1, err := s.Acquire(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	release2, err := s.Acquire(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := s.TryAcquire(1); ok {
+		t.Errorf("TryAcquire succeeded on full semaphore")
+	}
+
+	release1()
+	// Releasing more than once is a no-op.
+	release1()
+
+	release3, ok := s.TryAcquire(1)
+	if !ok {
+		t.Fatalf("TryAcquire failed after release")
+	}
+
+	if got, want := s.Metrics().InUse, int64(2); got != want {
+		t.Errorf("InUse = %d, want %d", got, want)
+	}
+
+	release2()
+	release3()
+
+	metrics := s.Metrics()
+	if got, want := metrics.InUse, int64(0); got != want {
+		t.Errorf("InUse = %d, want %d", got, want)
+	}
+	if got, want := metrics.Acquisitions, int64(3); got != want {
+		t.Errorf("Acquisitions = %d, want %d", got, want)
+	}
+}
+
+func TestSemaphoreAcquireInvalidWeight(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		weight int64
+	}{
+		{
+			name:   "zero",
+			weight: 0,
+		},
+		{
+			name:   "negative",
+			weight: -1,
+		},
+		{
+			name:   "exceeds capacity",
+			weight: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := NewSemaphore("test", 2)
+
+			if _, err := s.Acquire(context.Background(), testCase.weight); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
+
+func TestSemaphoreAcquireFIFO(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := NewSemaphore("test", 3)
+
+	release, err := s.Acquire(ctx, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A heavy waiter at the front of the queue must not be starved by later lighter waiters.
+	order := make(chan string, 2)
+	go func() {
+		release, err := s.Acquire(ctx, 3)
+		if err == nil {
+			order <- "heavy"
+			release()
+		}
+	}()
+	waitFor(t, func() bool { return s.Metrics().Waiting == 1 })
+
+	go func() {
+		release, err := s.Acquire(ctx, 1)
+		if err == nil {
+			order <- "light"
+			release()
+		}
+	}()
+	waitFor(t, func() bool { return s.Metrics().Waiting == 2 })
+
+	release()
+
+	for _, want := range []string{"heavy", "light"} {
+		select {
+		case got := <-order:
+			if got != want {
+				t.Errorf("acquired %q, want %q", got, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %q", want)
+		}
+	}
+}
+
+func TestSemaphoreAcquireTimeout(t *testing.T) {
+	t.Parallel()
+
+	s := NewSemaphore("test", 1, WithTimeout(50*time.Millisecond))
+
+	release, err := s.Acquire(WithHolderName(context.Background(), "holder"), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer release()
+
+	_, err = s.Acquire(WithHolderName(context.Background(), "waiter"), 1)
+
+	if !IsTimeoutError(err) {
+		t.Fatalf("err = %v, want TimeoutError", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got, want := err.Error(), "held by: holder"; !strings.Contains(got, want) {
+		t.Errorf("err = %q, want to contain %q", got, want)
+	}
+
+	metrics := s.Metrics()
+	if got, want := metrics.Timeouts, int64(1); got != want {
+		t.Errorf("Timeouts = %d, want %d", got, want)
+	}
+	if got, want := metrics.Waiting, int64(0); got != want {
+		t.Errorf("Waiting = %d, want %d", got, want)
+	}
+}
+
+func TestSemaphoreHolders(t *testing.T) {
+	t.Parallel()
+
+	s := NewSemaphore("test", 2)
+
+	release, err := s.Acquire(WithHolderName(context.Background(), "holder"), 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	holders := s.Holders()
+	if got, want := len(holders), 1; got != want {
+		t.Fatalf("length of holders = %d, want %d", got, want)
+	}
+	if got, want := holders[0].Name, "holder"; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+	if got, want := holders[0].Weight, int64(2); got != want {
+		t.Errorf("Weight = %d, want %d", got, want)
+	}
+
+	release()
+
+	if got, want := len(s.Holders()), 0; got != want {
+		t.Errorf("length of holders = %d, want %d", got, want)
+	}
+}
+
+func TestSemaphoreKVGet(t *testing.T) {
+	t.Parallel()
+
+	kv := NewSemaphoreKV()
+
+	s1 := kv.Get("key", 1)
+	s2 := kv.Get("key", 2)
+
+	if s1 != s2 {
+		t.Errorf("Get returned different semaphores for the same key")
+	}
+	if got, want := s2.Capacity(), int64(1); got != want {
+		t.Errorf("Capacity = %d, want %d", got, want)
+	}
+	if got, want := len(kv.Metrics()), 1; got != want {
+		t.Errorf("length of metrics = %d, want %d", got, want)
+	}
+}
+
+func waitFor(t *testing.T, f func() bool) {
+	t.Helper()
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if f() {
+			return
+		}
+	}
+
+	t.Fatalf("timed out waiting for condition")
+}
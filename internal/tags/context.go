@@ -10,6 +10,8 @@ import (
 type InContext struct {
 	DefaultConfig *DefaultConfig
 	IgnoreConfig  *IgnoreConfig
+	// NormalizeConfig holds any options for normalizing tag keys and values.
+	NormalizeConfig *NormalizeConfig
 	// TagsIn holds tags specified in configuration. Typically this field includes any default tags and excludes system tags.
 	TagsIn types.Option[KeyValueTags]
 	// TagsOut holds tags returned from AWS, including any ignored or system tags.
@@ -17,12 +19,13 @@ type InContext struct {
 }
 
 // NewContext returns a Context enhanced with tagging information.
-func NewContext(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig) context.Context {
+func NewContext(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, normalizeConfig *NormalizeConfig) context.Context {
 	v := InContext{
-		DefaultConfig: defaultConfig,
-		IgnoreConfig:  ignoreConfig,
-		TagsIn:        types.None[KeyValueTags](),
-		TagsOut:       types.None[KeyValueTags](),
+		DefaultConfig:   defaultConfig,
+		IgnoreConfig:    ignoreConfig,
+		NormalizeConfig: normalizeConfig,
+		TagsIn:          types.None[KeyValueTags](),
+		TagsOut:         types.None[KeyValueTags](),
 	}
 
 	return context.WithValue(ctx, tagKey, &v)
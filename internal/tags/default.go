@@ -0,0 +1,49 @@
+package tags
+
+import (
+	"golang.org/x/exp/slices"
+)
+
+// ScopedDefaultConfig contains tags to default across resources matching a selector.
+type ScopedDefaultConfig struct {
+	Tags                 KeyValueTags
+	IncludeResourceTypes []string // Terraform resource types, e.g. "aws_instance".
+	IncludeServices      []string // Service package names, e.g. "ec2".
+	ExcludeResourceTypes []string
+	ExcludeServices      []string
+}
+
+// matches returns whether the selector matches the specified resource.
+// A resource matches if no include selector is set or it is included by any include selector,
+// and it is not excluded by any exclude selector.
+func (sdc ScopedDefaultConfig) matches(servicePackageName, typeName string) bool {
+	if slices.Contains(sdc.ExcludeResourceTypes, typeName) || slices.Contains(sdc.ExcludeServices, servicePackageName) {
+		return false
+	}
+
+	if len(sdc.IncludeResourceTypes) == 0 && len(sdc.IncludeServices) == 0 {
+		return true
+	}
+
+	return slices.Contains(sdc.IncludeResourceTypes, typeName) || slices.Contains(sdc.IncludeServices, servicePackageName)
+}
+
+// ForResource returns the default tags configuration applicable to the specified resource.
+// Tags from matching scoped configurations are merged, in order, on to the tags defaulted across all resources.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil || len(dc.ScopedTags) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+
+	for _, v := range dc.ScopedTags {
+		if v.matches(servicePackageName, typeName) {
+			tags = tags.Merge(v.Tags)
+		}
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
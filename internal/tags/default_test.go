@@ -0,0 +1,130 @@
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Owner": "platform",
+		}),
+		ScopedTags: []ScopedDefaultConfig{
+			{
+				Tags: New(ctx, map[string]string{
+					"CostCenter": "cc-1",
+				}),
+				IncludeServices:      []string{"ec2"},
+				ExcludeResourceTypes: []string{"aws_ec2_tag"},
+			},
+			{
+				Tags: New(ctx, map[string]string{
+					"CostCenter": "cc-2",
+					"Owner":      "data",
+				}),
+				IncludeResourceTypes: []string{"aws_db_instance", "aws_instance"},
+			},
+			{
+				Tags: New(ctx, map[string]string{
+					"Backup": "true",
+				}),
+				ExcludeServices: []string{"iam"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		servicePackageName string
+		typeName           string
+		want               map[string]string
+	}{
+		{
+			name:               "nil config",
+			servicePackageName: "ec2",
+			typeName:           "aws_vpc",
+		},
+		{
+			name: "no scoped tags",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Owner": "platform",
+				}),
+			},
+			servicePackageName: "ec2",
+			typeName:           "aws_vpc",
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+		{
+			name:               "included service",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_vpc",
+			want: map[string]string{
+				"Backup":     "true",
+				"CostCenter": "cc-1",
+				"Owner":      "platform",
+			},
+		},
+		{
+			name:               "excluded resource type",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_ec2_tag",
+			want: map[string]string{
+				"Backup": "true",
+				"Owner":  "platform",
+			},
+		},
+		{
+			name:               "later selector overrides",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want: map[string]string{
+				"Backup":     "true",
+				"CostCenter": "cc-2",
+				"Owner":      "data",
+			},
+		},
+		{
+			name:               "excluded service",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "iam",
+			typeName:           "aws_iam_role",
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.servicePackageName, testCase.typeName)
+
+			if testCase.want == nil {
+				if got != nil {
+					t.Errorf("got %v, want nil", got)
+				}
+
+				return
+			}
+
+			if len(got.ScopedTags) != 0 {
+				t.Errorf("got %d scoped tags, want none", len(got.ScopedTags))
+			}
+
+			testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.want)
+		})
+	}
+}
@@ -30,6 +30,9 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// ScopedTags contains tags to default across only those resources matching a selector.
+	// Use ForResource to resolve the tags that apply to a resource.
+	ScopedTags []ScopedDefaultConfig
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -806,6 +809,14 @@ func (tags KeyValueTags) ResolveDuplicates(ctx context.Context, defaultConfig *D
 		}
 	}
 
+	resolveDuplicates(result, defaultConfig, configTags, configExists)
+
+	return New(ctx, result).IgnoreConfig(ignoreConfig)
+}
+
+// resolveDuplicates adds to result, which holds the tags that differ from the default tags,
+// those incoming tags that duplicate a default tag but are set on the resource.
+func resolveDuplicates(result map[string]string, defaultConfig *DefaultConfig, configTags map[string]configTag, configExists bool) {
 	for k, v := range configTags {
 		if _, ok := result[k]; !ok {
 			if defaultConfig != nil {
@@ -823,8 +834,6 @@ func (tags KeyValueTags) ResolveDuplicates(ctx context.Context, defaultConfig *D
 			}
 		}
 	}
-
-	return New(ctx, result).IgnoreConfig(ignoreConfig)
 }
 
 // ResolveDuplicatesFramework resolves differences between incoming tags, defaultTags, and ignoreConfig
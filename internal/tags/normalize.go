@@ -0,0 +1,154 @@
+package tags
+
+import (
+	"sort"
+	"strings"
+)
+
+// Tag key case styles.
+const (
+	KeyCaseLower = "lower"
+	KeyCaseUpper = "upper"
+)
+
+func KeyCase_Values() []string {
+	return []string{
+		KeyCaseLower,
+		KeyCaseUpper,
+	}
+}
+
+// Maximum tag key and value lengths, in Unicode characters, supported by most AWS services.
+const (
+	maxKeyLength   = 128
+	maxValueLength = 256
+)
+
+// NormalizeConfig contains options for normalizing tag keys and values before comparison and sending to AWS.
+type NormalizeConfig struct {
+	KeyCase        string // One of the KeyCase values. Empty means key case is unchanged.
+	TrimWhitespace bool
+	Truncate       bool // Truncate keys and values to the maximum lengths supported by most AWS services.
+}
+
+// transforms returns the normalizing transforms, in the order they are applied.
+func (nc *NormalizeConfig) transforms() (keyTransforms, valueTransforms []func(string) string) {
+	if nc.TrimWhitespace {
+		keyTransforms = append(keyTransforms, strings.TrimSpace)
+		valueTransforms = append(valueTransforms, strings.TrimSpace)
+	}
+
+	switch nc.KeyCase {
+	case KeyCaseLower:
+		keyTransforms = append(keyTransforms, strings.ToLower)
+	case KeyCaseUpper:
+		keyTransforms = append(keyTransforms, strings.ToUpper)
+	}
+
+	if nc.Truncate {
+		keyTransforms = append(keyTransforms, truncate(maxKeyLength))
+		valueTransforms = append(valueTransforms, truncate(maxValueLength))
+
+		// Truncation can leave trailing whitespace.
+		if nc.TrimWhitespace {
+			keyTransforms = append(keyTransforms, strings.TrimSpace)
+			valueTransforms = append(valueTransforms, strings.TrimSpace)
+		}
+	}
+
+	return keyTransforms, valueTransforms
+}
+
+func truncate(n int) func(string) string {
+	return func(s string) string {
+		if r := []rune(s); len(r) > n {
+			return string(r[:n])
+		}
+
+		return s
+	}
+}
+
+func apply(transforms []func(string) string, s string) string {
+	for _, f := range transforms {
+		s = f(s)
+	}
+
+	return s
+}
+
+// Normalize returns tags with keys and values normalized per the configuration.
+// If normalization makes several keys equal, the value of the lexically greatest original key is kept.
+func (tags KeyValueTags) Normalize(nc *NormalizeConfig) KeyValueTags {
+	if nc == nil || tags == nil {
+		return tags
+	}
+
+	keyTransforms, valueTransforms := nc.transforms()
+	result := make(KeyValueTags, len(tags))
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := tags[k]
+
+		if v != nil && v.Value != nil {
+			data := *v
+			value := apply(valueTransforms, *v.Value)
+			data.Value = &value
+			v = &data
+		}
+
+		result[apply(keyTransforms, k)] = v
+	}
+
+	return result
+}
+
+// Normalize returns the default tags configuration with tags normalized per the configuration.
+func (dc *DefaultConfig) Normalize(nc *NormalizeConfig) *DefaultConfig {
+	if dc == nil || nc == nil {
+		return dc
+	}
+
+	result := &DefaultConfig{
+		Tags: dc.Tags.Normalize(nc),
+	}
+
+	for _, v := range dc.ScopedTags {
+		v.Tags = v.Tags.Normalize(nc)
+		result.ScopedTags = append(result.ScopedTags, v)
+	}
+
+	return result
+}
+
+// Denormalize returns tags in which any tag equal to the normalized form of a configured tag is replaced by the configured tag.
+// This prevents differences between the configured tags and the normalized tags returned from AWS.
+func (tags KeyValueTags) Denormalize(nc *NormalizeConfig, configTags KeyValueTags) KeyValueTags {
+	if nc == nil {
+		return tags
+	}
+
+	keyTransforms, valueTransforms := nc.transforms()
+	result := make(KeyValueTags, len(tags))
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	for k, v := range configTags {
+		if v == nil {
+			continue
+		}
+
+		normalizedKey := apply(keyTransforms, k)
+
+		if tag, ok := tags[normalizedKey]; ok && tag.ValueString() == apply(valueTransforms, v.ValueString()) {
+			delete(result, normalizedKey)
+			result[k] = v
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,172 @@
+package tags
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestKeyValueTagsNormalize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name   string
+		config *NormalizeConfig
+		tags   KeyValueTags
+		want   map[string]string
+	}{
+		{
+			name: "nil config",
+			tags: New(ctx, map[string]string{
+				" Key1 ": " value1 ",
+			}),
+			want: map[string]string{
+				" Key1 ": " value1 ",
+			},
+		},
+		{
+			name:   "trim whitespace",
+			config: &NormalizeConfig{TrimWhitespace: true},
+			tags: New(ctx, map[string]string{
+				" Key1 ": " value1 ",
+				"Key2":   "value2\t",
+			}),
+			want: map[string]string{
+				"Key1": "value1",
+				"Key2": "value2",
+			},
+		},
+		{
+			name:   "lower case keys",
+			config: &NormalizeConfig{KeyCase: KeyCaseLower},
+			tags: New(ctx, map[string]string{
+				"Key1": "Value1",
+			}),
+			want: map[string]string{
+				"key1": "Value1",
+			},
+		},
+		{
+			name:   "upper case keys",
+			config: &NormalizeConfig{KeyCase: KeyCaseUpper},
+			tags: New(ctx, map[string]string{
+				"Key1": "Value1",
+			}),
+			want: map[string]string{
+				"KEY1": "Value1",
+			},
+		},
+		{
+			name:   "colliding keys",
+			config: &NormalizeConfig{KeyCase: KeyCaseLower},
+			tags: New(ctx, map[string]string{
+				"KEY1": "value1",
+				"Key1": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value2",
+			},
+		},
+		{
+			name:   "truncate",
+			config: &NormalizeConfig{Truncate: true},
+			tags: New(ctx, map[string]string{
+				strings.Repeat("k", 130): strings.Repeat("v", 260),
+			}),
+			want: map[string]string{
+				strings.Repeat("k", 128): strings.Repeat("v", 256),
+			},
+		},
+		{
+			name:   "truncate and trim whitespace",
+			config: &NormalizeConfig{TrimWhitespace: true, Truncate: true},
+			tags: New(ctx, map[string]string{
+				"key1": strings.Repeat("v", 255) + " value",
+			}),
+			want: map[string]string{
+				"key1": strings.Repeat("v", 255),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.Normalize(testCase.config)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsDenormalize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &NormalizeConfig{
+		KeyCase:        KeyCaseLower,
+		TrimWhitespace: true,
+	}
+	testCases := []struct {
+		name       string
+		config     *NormalizeConfig
+		tags       KeyValueTags
+		configTags KeyValueTags
+		want       map[string]string
+	}{
+		{
+			name: "nil config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			configTags: New(ctx, map[string]string{
+				"Key1 ": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name:   "normalized configured tag",
+			config: config,
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			configTags: New(ctx, map[string]string{
+				"Key1 ": " value1",
+			}),
+			want: map[string]string{
+				"Key1 ": " value1",
+				"key2":  "value2",
+			},
+		},
+		{
+			name:   "changed value",
+			config: config,
+			tags: New(ctx, map[string]string{
+				"key1": "value2",
+			}),
+			configTags: New(ctx, map[string]string{
+				"Key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.Denormalize(testCase.config, testCase.configTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
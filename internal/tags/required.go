@@ -0,0 +1,105 @@
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// RequiredConfig contains the tagging policy enforced across all taggable resources.
+type RequiredConfig struct {
+	Rules []RequiredRule
+}
+
+// RequiredRule is a single required tag.
+type RequiredRule struct {
+	Key           string
+	ValuePatterns []*regexp.Regexp // Allowed values. Empty means any value is allowed.
+	ResourceTypes []string         // Terraform resource types the rule applies to. Empty means all resources.
+}
+
+// NewRequiredRule returns a required tag rule.
+// Each value pattern is a regular expression that must match the whole tag value.
+func NewRequiredRule(key string, valuePatterns, resourceTypes []string) (RequiredRule, error) {
+	rule := RequiredRule{
+		Key:           key,
+		ResourceTypes: resourceTypes,
+	}
+
+	for _, v := range valuePatterns {
+		re, err := regexp.Compile(`^(?:` + v + `)$`)
+
+		if err != nil {
+			return RequiredRule{}, fmt.Errorf("required tag (%s) value pattern (%s): %w", key, v, err)
+		}
+
+		rule.ValuePatterns = append(rule.ValuePatterns, re)
+	}
+
+	return rule, nil
+}
+
+// appliesTo returns whether the rule applies to the specified resource type.
+func (rule RequiredRule) appliesTo(typeName string) bool {
+	return len(rule.ResourceTypes) == 0 || slices.Contains(rule.ResourceTypes, typeName)
+}
+
+// check returns a description of the rule violation, or the empty string if the tags comply.
+func (rule RequiredRule) check(tags KeyValueTags) string {
+	v, ok := tags[rule.Key]
+
+	if !ok {
+		return fmt.Sprintf("missing required tag %q", rule.Key)
+	}
+
+	if len(rule.ValuePatterns) == 0 {
+		return ""
+	}
+
+	var value string
+	if v != nil {
+		value = v.ValueString()
+	}
+
+	for _, re := range rule.ValuePatterns {
+		if re.MatchString(value) {
+			return ""
+		}
+	}
+
+	patterns := make([]string, len(rule.ValuePatterns))
+	for i, re := range rule.ValuePatterns {
+		patterns[i] = strings.TrimSuffix(strings.TrimPrefix(re.String(), `^(?:`), `)$`)
+	}
+
+	return fmt.Sprintf("tag %q value %q does not match any allowed pattern (%s)", rule.Key, value, strings.Join(patterns, ", "))
+}
+
+// Check returns an error if the specified resource's tags violate the tagging policy.
+// `tags` are the resource's tags merged with the provider's default tags and normalized, before `ignore_tags` is applied:
+// ignored tags are still sent to AWS, so they count toward the policy.
+func (rc *RequiredConfig) Check(typeName string, tags KeyValueTags) error {
+	if rc == nil {
+		return nil
+	}
+
+	var violations []string
+
+	for _, rule := range rc.Rules {
+		if !rule.appliesTo(typeName) {
+			continue
+		}
+
+		if v := rule.check(tags); v != "" {
+			violations = append(violations, v)
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s violates the provider's required_tags policy: %s", typeName, strings.Join(violations, "; "))
+}
@@ -0,0 +1,124 @@
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewRequiredRule(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		valuePatterns []string
+		expectError   bool
+	}{
+		{
+			name: "no value patterns",
+		},
+		{
+			name:          "valid value patterns",
+			valuePatterns: []string{`cc-[0-9]+`, `shared`},
+		},
+		{
+			name:          "invalid value pattern",
+			valuePatterns: []string{`cc-[0-9`},
+			expectError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewRequiredRule("CostCenter", testCase.valuePatterns, nil)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestRequiredConfigCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mustRule := func(key string, valuePatterns, resourceTypes []string) RequiredRule {
+		rule, err := NewRequiredRule(key, valuePatterns, resourceTypes)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return rule
+	}
+
+	testCases := []struct {
+		name        string
+		config      *RequiredConfig
+		typeName    string
+		tags        KeyValueTags
+		expectError bool
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_vpc",
+			tags:     New(ctx, map[string]string{}),
+		},
+		{
+			name:     "has required tag",
+			config:   &RequiredConfig{Rules: []RequiredRule{mustRule("Owner", nil, nil)}},
+			typeName: "aws_vpc",
+			tags:     New(ctx, map[string]string{"Owner": "team-a"}),
+		},
+		{
+			name:        "missing required tag",
+			config:      &RequiredConfig{Rules: []RequiredRule{mustRule("Owner", nil, nil)}},
+			typeName:    "aws_vpc",
+			tags:        New(ctx, map[string]string{"Name": "example"}),
+			expectError: true,
+		},
+		{
+			name:     "allowed value",
+			config:   &RequiredConfig{Rules: []RequiredRule{mustRule("CostCenter", []string{`cc-[0-9]+`, `shared`}, nil)}},
+			typeName: "aws_vpc",
+			tags:     New(ctx, map[string]string{"CostCenter": "shared"}),
+		},
+		{
+			name:        "value not allowed",
+			config:      &RequiredConfig{Rules: []RequiredRule{mustRule("CostCenter", []string{`cc-[0-9]+`}, nil)}},
+			typeName:    "aws_vpc",
+			tags:        New(ctx, map[string]string{"CostCenter": "cc-123x"}),
+			expectError: true,
+		},
+		{
+			name:     "other resource type",
+			config:   &RequiredConfig{Rules: []RequiredRule{mustRule("Owner", nil, []string{"aws_instance"})}},
+			typeName: "aws_vpc",
+			tags:     New(ctx, map[string]string{}),
+		},
+		{
+			name:        "scoped resource type",
+			config:      &RequiredConfig{Rules: []RequiredRule{mustRule("Owner", nil, []string{"aws_instance", "aws_vpc"})}},
+			typeName:    "aws_vpc",
+			tags:        New(ctx, map[string]string{}),
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.config.Check(testCase.typeName, testCase.tags)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
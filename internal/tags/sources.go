@@ -0,0 +1,54 @@
+package tags
+
+// Sources of a resource's tags.
+const (
+	TagSourceDefaultTags = "default_tags"
+	TagSourceIgnoreTags  = "ignore_tags"
+	TagSourceTags        = "tags"
+)
+
+func TagSource_Values() []string {
+	return []string{
+		TagSourceDefaultTags,
+		TagSourceIgnoreTags,
+		TagSourceTags,
+	}
+}
+
+// Sources returns the source of each key in the merge of the provider's default tags and the resource's tags.
+// Keys removed by the ignore configuration are reported as ignored. As in ResolveDuplicates, keys whose value
+// differs from the default tag with the same key or that are configured on the resource are reported as tags,
+// and the remaining keys as default tags.
+func (tags KeyValueTags) Sources(defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig) map[string]string {
+	allTags := defaultConfig.MergeTags(tags)
+	effectiveTags := allTags.IgnoreConfig(ignoreConfig)
+
+	resourceTags := make(map[string]string)
+	for k, v := range allTags.RemoveDefaultConfig(defaultConfig) {
+		resourceTags[k] = v.ValueString()
+	}
+
+	configTags := make(map[string]configTag, len(tags))
+	for k, v := range tags {
+		configTags[k] = configTag{
+			value:  v.ValueString(),
+			source: configuration,
+		}
+	}
+
+	resolveDuplicates(resourceTags, defaultConfig, configTags, true)
+
+	result := make(map[string]string, len(allTags))
+	for k := range allTags {
+		switch _, ok := resourceTags[k]; {
+		case !effectiveTags.KeyExists(k):
+			result[k] = TagSourceIgnoreTags
+		case ok:
+			result[k] = TagSourceTags
+		default:
+			result[k] = TagSourceDefaultTags
+		}
+	}
+
+	return result
+}
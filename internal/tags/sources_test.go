@@ -0,0 +1,115 @@
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestKeyValueTagsSources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		ignoreConfig  *IgnoreConfig
+		want          map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(ctx, map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "resource tags only",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": TagSourceTags,
+			},
+		},
+		{
+			name: "default tags only",
+			tags: New(ctx, map[string]string{}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": TagSourceDefaultTags,
+			},
+		},
+		{
+			name: "resource tags override default tags",
+			tags: New(ctx, map[string]string{
+				"key1": "value2",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key3": "value3",
+				}),
+			},
+			want: map[string]string{
+				"key1": TagSourceTags,
+				"key2": TagSourceTags,
+				"key3": TagSourceDefaultTags,
+			},
+		},
+		{
+			name: "resource tags duplicate default tags",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": TagSourceTags,
+				"key2": TagSourceDefaultTags,
+			},
+		},
+		{
+			name: "ignored keys and prefixes",
+			tags: New(ctx, map[string]string{
+				"key1":      "value1",
+				"ignored:a": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key2": "value2",
+					"key3": "value3",
+				}),
+			},
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New(ctx, []string{"key2"}),
+				KeyPrefixes: New(ctx, []string{"ignored:"}),
+			},
+			want: map[string]string{
+				"ignored:a": TagSourceIgnoreTags,
+				"key1":      TagSourceTags,
+				"key2":      TagSourceIgnoreTags,
+				"key3":      TagSourceDefaultTags,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.Sources(testCase.defaultConfig, testCase.ignoreConfig)
+
+			testKeyValueTagsVerifyMap(t, got, testCase.want)
+		})
+	}
+}
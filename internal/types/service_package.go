@@ -14,6 +14,12 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceConcurrency represents resource-level concurrency limits.
+type ServicePackageResourceConcurrency struct {
+	Key   string // Resources with the same key share a concurrency limit. Defaults to the resource type name.
+	Limit int64  // Maximum number of concurrent Create, Update and Delete operations.
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -25,9 +31,10 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory     func(context.Context) (resource.ResourceWithConfigure, error)
+	Name        string
+	Tags        *ServicePackageResourceTags
+	Concurrency *ServicePackageResourceConcurrency
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -42,8 +49,9 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory     func() *schema.Resource
+	TypeName    string
+	Name        string
+	Tags        *ServicePackageResourceTags
+	Concurrency *ServicePackageResourceConcurrency
 }
@@ -0,0 +1,328 @@
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+var (
+	// Service signing name from a Signature Version 4 Authorization header.
+	authorizationServiceRegexp = regexp.MustCompile(`Credential=[^/]+/\d{8}/[^/]+/([^/]+)/aws4_request`)
+)
+
+var (
+	ignoredRequestFieldsLock sync.RWMutex
+	// Request fields whose values differ between otherwise identical requests, keyed by service signing name.
+	// Fields keyed by the empty string are ignored for all services. Field names are case-insensitive.
+	ignoredRequestFields = map[string][]string{
+		"": {
+			// Idempotency tokens.
+			"CallerReference",
+			"ClientRequestToken",
+			"ClientToken",
+			"IdempotencyToken",
+			// Timestamps, e.g. the time range of CloudWatch metric and log queries.
+			"EndTime",
+			"StartTime",
+		},
+	}
+
+	xmlElementRegexpsLock sync.Mutex
+	// Regular expressions matching simple XML elements, keyed by lowercase element name.
+	xmlElementRegexps = make(map[string]*regexp.Regexp)
+)
+
+// IgnoreRequestFields adds request fields that are ignored when matching requests for the specified service to recorded interactions.
+// serviceName is the service's signing name, e.g. "ec2". Use the empty string to ignore the fields for all services.
+func IgnoreRequestFields(serviceName string, fields ...string) {
+	ignoredRequestFieldsLock.Lock()
+	defer ignoredRequestFieldsLock.Unlock()
+
+	ignoredRequestFields[serviceName] = append(ignoredRequestFields[serviceName], fields...)
+}
+
+// ignoredFields returns the request fields ignored for the specified service.
+func ignoredFields(serviceName string) []string {
+	ignoredRequestFieldsLock.RLock()
+	defer ignoredRequestFieldsLock.RUnlock()
+
+	fields := append([]string{}, ignoredRequestFields[""]...)
+	if serviceName != "" {
+		fields = append(fields, ignoredRequestFields[serviceName]...)
+	}
+
+	return fields
+}
+
+func isIgnoredField(fields []string, name string) bool {
+	for _, v := range fields {
+		if strings.EqualFold(v, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ServiceName returns the signing name of the service a request is sent to.
+// The first label of the request's host name is used for unsigned requests.
+func ServiceName(r *http.Request) string {
+	if m := authorizationServiceRegexp.FindStringSubmatch(r.Header.Get("Authorization")); len(m) > 1 {
+		return m[1]
+	}
+
+	host, _, _ := strings.Cut(r.URL.Hostname(), ".")
+
+	return host
+}
+
+// RequestOperation returns the service signing name and API operation name of a request.
+// The operation name is not available for REST protocol requests and "" is returned.
+// The request body is read and replaced.
+func RequestOperation(r *http.Request) (string, string, error) {
+	service := ServiceName(r)
+
+	// JSON protocol.
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		_, operation, _ := strings.Cut(target, ".")
+
+		return service, operation, nil
+	}
+
+	// Query protocol.
+	if contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); contentType == "application/x-www-form-urlencoded" && r.Body != nil {
+		body, err := io.ReadAll(r.Body)
+
+		if err != nil {
+			return "", "", fmt.Errorf("reading request body: %w", err)
+		}
+
+		r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		values, err := url.ParseQuery(string(body))
+
+		if err != nil {
+			return "", "", fmt.Errorf("parsing request body: %w", err)
+		}
+
+		return service, values.Get("Action"), nil
+	}
+
+	return service, "", nil
+}
+
+// Matcher returns a function that defines how VCR will match requests to responses.
+// Requests are redacted in the same way as recorded interactions before matching and
+// service-specific request fields (e.g. idempotency tokens and timestamps) are ignored.
+func Matcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		// Default matcher compares method and URL only.
+		if r.Method != i.Method || redactBody(r.URL.String()) != i.URL {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := redactBody(b.String())
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		fields := ignoredFields(ServiceName(r))
+		contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		switch contentType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			var requestJson, cassetteJson interface{}
+
+			if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(removeJSONFields(requestJson, fields), removeJSONFields(cassetteJson, fields))
+
+		case "application/x-www-form-urlencoded":
+			// AWS query protocol. Parameters might be the same, but reordered.
+			requestQuery, err := url.ParseQuery(body)
+
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse request query", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			cassetteQuery, err := url.ParseQuery(i.Body)
+
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse cassette query", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(removeQueryParameters(requestQuery, fields), removeQueryParameters(cassetteQuery, fields))
+
+		case "application/xml", "text/xml":
+			// Remove ignored elements and compare the remaining XML.
+			if removeXMLElements(body, fields) == removeXMLElements(i.Body, fields) {
+				return true
+			}
+
+			// XML might be the same, but reordered. Try parsing and comparing.
+			var requestXml, cassetteXml interface{}
+
+			if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestXml, cassetteXml)
+		}
+
+		return false
+	}
+}
+
+// removeJSONFields returns the specified unmarshaled JSON value with the specified object fields removed at any depth.
+// Timestamp values are replaced by a placeholder.
+func removeJSONFields(v interface{}, fields []string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+
+		for k, v := range v {
+			if isIgnoredField(fields, k) {
+				continue
+			}
+
+			result[k] = removeJSONFields(v, fields)
+		}
+
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+
+		for i, v := range v {
+			result[i] = removeJSONFields(v, fields)
+		}
+
+		return result
+	case string:
+		return redactTimestamp(v)
+	default:
+		return v
+	}
+}
+
+// removeQueryParameters returns the specified query parameters without those whose name, or last name component
+// for nested parameters (e.g. "LaunchTemplateData.ClientToken"), is one of the specified fields.
+// Timestamp values are replaced by a placeholder.
+func removeQueryParameters(v url.Values, fields []string) url.Values {
+	result := make(url.Values, len(v))
+
+	for k, v := range v {
+		name := k
+		if i := strings.LastIndex(k, "."); i >= 0 {
+			name = k[i+1:]
+		}
+
+		if isIgnoredField(fields, name) {
+			continue
+		}
+
+		values := make([]string, len(v))
+		for i, v := range v {
+			values[i] = redactTimestamp(v)
+		}
+
+		result[k] = values
+	}
+
+	return result
+}
+
+// redactTimestamp returns a placeholder if the specified request value is an RFC 3339 timestamp, as generated for
+// example by the Terraform `timestamp` function, and otherwise returns the value.
+func redactTimestamp(v string) string {
+	if _, err := time.Parse(time.RFC3339, v); err == nil {
+		return "TIMESTAMP"
+	}
+
+	return v
+}
+
+// removeXMLElements returns the specified XML document without any simple elements named as one of the specified fields.
+func removeXMLElements(s string, fields []string) string {
+	for _, v := range fields {
+		s = xmlElementRegexp(v).ReplaceAllString(s, "")
+	}
+
+	return s
+}
+
+// xmlElementRegexp returns a regular expression matching simple XML elements with the specified name, ignoring case.
+// Regular expressions are compiled once.
+func xmlElementRegexp(name string) *regexp.Regexp {
+	name = strings.ToLower(name)
+
+	xmlElementRegexpsLock.Lock()
+	defer xmlElementRegexpsLock.Unlock()
+
+	re, ok := xmlElementRegexps[name]
+	if !ok {
+		re = regexp.MustCompile(fmt.Sprintf(`(?i)<%[1]s>[^<]*</%[1]s>`, regexp.QuoteMeta(name)))
+		xmlElementRegexps[name] = re
+	}
+
+	return re
+}
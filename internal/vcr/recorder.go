@@ -0,0 +1,102 @@
+package vcr
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+// saveDelay is the time to wait after an interaction is recorded before saving the cassette.
+// Interactions recorded during the delay are saved together.
+const saveDelay = 5 * time.Second
+
+// NewPersistentRecorder returns an http.RoundTripper that records AWS API interactions to, or replays them from, the specified cassette.
+// Credentials and request signatures are removed from recorded interactions.
+// When recording, the cassette is saved shortly after interactions are recorded as a provider process can exit without the recorder being closed,
+// and when the recorder is closed.
+func NewPersistentRecorder(ctx context.Context, cassetteName string, mode recorder.Mode, realTransport http.RoundTripper) (*PersistentRecorder, error) {
+	r, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:       cassetteName,
+		Mode:               mode,
+		RealTransport:      realTransport,
+		SkipRequestLatency: true,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.AddHook(RedactSensitiveData, recorder.AfterCaptureHook)
+	r.SetMatcher(Matcher(ctx))
+	// The same API call may be made many times, e.g. for data sources and resources referencing the same AWS object.
+	r.SetReplayableInteractions(true)
+
+	return &PersistentRecorder{
+		ctx:      ctx,
+		recorder: r,
+	}, nil
+}
+
+// PersistentRecorder records AWS API interactions to, or replays them from, a cassette.
+type PersistentRecorder struct {
+	ctx      context.Context
+	recorder *recorder.Recorder
+	// lock is held for reading while interactions are captured and for writing while the cassette is saved.
+	lock      sync.RWMutex
+	saveLock  sync.Mutex
+	saveTimer *time.Timer
+}
+
+func (r *PersistentRecorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	r.lock.RLock()
+	response, err := r.recorder.RoundTrip(request)
+	r.lock.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if r.recorder.IsRecording() {
+		r.saveLock.Lock()
+		if r.saveTimer == nil {
+			r.saveTimer = time.AfterFunc(saveDelay, func() {
+				if err := r.save(); err != nil {
+					// The AWS API call succeeded so the error is logged rather than returned.
+					tflog.Warn(r.ctx, "saving AWS API recording", map[string]any{
+						"error": err.Error(),
+					})
+				}
+			})
+		}
+		r.saveLock.Unlock()
+	}
+
+	return response, nil
+}
+
+// Close saves any recorded interactions.
+func (r *PersistentRecorder) Close() error {
+	if !r.recorder.IsRecording() {
+		return nil
+	}
+
+	return r.save()
+}
+
+func (r *PersistentRecorder) save() error {
+	r.saveLock.Lock()
+	if r.saveTimer != nil {
+		r.saveTimer.Stop()
+		r.saveTimer = nil
+	}
+	r.saveLock.Unlock()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.recorder.Stop()
+}
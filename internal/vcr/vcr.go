@@ -0,0 +1,135 @@
+// Package vcr contains helpers for recording and replaying AWS API interactions.
+// It is used by both the acceptance test framework and the provider's API recording mode.
+package vcr
+
+import (
+	"crypto/tls"
+	"net/http"
+	"regexp"
+	"sync"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	redactedValue = "REDACTED"
+	// Replaces AWS account IDs. The well-known example account ID is used so that redacted ARNs remain valid.
+	redactedAccountID = "123456789012"
+	// Replaces the 16 characters following the access key ID prefix.
+	redactedAccessKeyIDSuffix = "REDACTEDREDACTED"
+)
+
+var (
+	// Request headers carrying credentials or request signatures.
+	sensitiveRequestHeaders = []string{
+		"Authorization",
+		"X-Amz-Content-Sha256",
+		"X-Amz-Date",
+		"X-Amz-Security-Token",
+	}
+
+	// Credentials returned in XML (e.g. STS AssumeRole) or JSON (e.g. SSO GetRoleCredentials) response bodies.
+	sensitiveXMLElementRegexp = regexp.MustCompile(`(?i)(<(?:SecretAccessKey|SessionToken)>)[^<]*(</)`)
+	sensitiveJSONFieldRegexp  = regexp.MustCompile(`(?i)("(?:SecretAccessKey|SessionToken)"\s*:\s*")[^"]*(")`)
+
+	// Long-term (AKIA) and temporary (ASIA) access key IDs.
+	accessKeyIDRegexp = regexp.MustCompile(`\b(AKIA|ASIA)[A-Z0-9]{16}\b`)
+
+	// Account IDs in ARNs (including URL-encoded ARNs), in resource URLs (e.g. SQS queue URLs) and in XML or JSON account ID and owner ID fields.
+	arnAccountIDRegexp        = regexp.MustCompile(`(?i)(arn(?::|%3A)[\w-]+(?::|%3A)[\w-]*(?::|%3A)[\w-]*(?::|%3A))\d{12}(:|%3A)`)
+	urlAccountIDRegexp        = regexp.MustCompile(`(amazonaws\.com(?:\.cn)?/)\d{12}(/)`)
+	xmlAccountIDElementRegexp = regexp.MustCompile(`(?i)(<(?:Account|AccountId|OwnerId)>)\d{12}(</)`)
+	jsonAccountIDFieldRegexp  = regexp.MustCompile(`(?i)("(?:Account|AccountId|OwnerId)"\s*:\s*")\d{12}(")`)
+	queryAccountIDParamRegexp = regexp.MustCompile(`(?i)((?:^|[&?])(?:AccountId|OwnerId)=)\d{12}\b`)
+)
+
+// A Redactor replaces sensitive values in a URL or a request or response body.
+type Redactor func(string) string
+
+var (
+	redactorsLock sync.RWMutex
+	redactors     = []Redactor{
+		RedactCredentials,
+		RedactAccessKeyIDs,
+		RedactAccountIDs,
+	}
+)
+
+// RegisterRedactor adds a Redactor to those applied to all recorded interactions.
+// Redactors must be idempotent as they are also applied to requests when matching them to recorded interactions.
+func RegisterRedactor(r Redactor) {
+	redactorsLock.Lock()
+	defer redactorsLock.Unlock()
+
+	redactors = append(redactors, r)
+}
+
+// RedactCredentials replaces secret access keys and session tokens.
+func RedactCredentials(s string) string {
+	s = sensitiveXMLElementRegexp.ReplaceAllString(s, "${1}"+redactedValue+"${2}")
+	s = sensitiveJSONFieldRegexp.ReplaceAllString(s, "${1}"+redactedValue+"${2}")
+
+	return s
+}
+
+// RedactAccessKeyIDs replaces access key IDs, keeping their prefix.
+func RedactAccessKeyIDs(s string) string {
+	return accessKeyIDRegexp.ReplaceAllString(s, "${1}"+redactedAccessKeyIDSuffix)
+}
+
+// RedactAccountIDs replaces AWS account IDs in ARNs, resource URLs and account ID fields.
+func RedactAccountIDs(s string) string {
+	for _, re := range []*regexp.Regexp{arnAccountIDRegexp, urlAccountIDRegexp, xmlAccountIDElementRegexp, jsonAccountIDFieldRegexp, queryAccountIDParamRegexp} {
+		s = re.ReplaceAllString(s, "${1}"+redactedAccountID+"${2}")
+	}
+
+	return s
+}
+
+// NewHTTPClient returns a new HTTP client suitable for wrapping with a recorder.
+func NewHTTPClient() *http.Client {
+	// Cribbed from aws-sdk-go-base.
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.MaxIdleConnsPerHost = 10
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+		transport.TLSClientConfig = tlsConfig
+	}
+	tlsConfig.MinVersion = tls.VersionTLS12
+
+	return httpClient
+}
+
+// RedactSensitiveData removes credentials, request signing information, account IDs and any values matched by registered
+// Redactors from an interaction.
+// It is intended to be used as a recorder.AfterCaptureHook.
+func RedactSensitiveData(i *cassette.Interaction) error {
+	for _, v := range sensitiveRequestHeaders {
+		delete(i.Request.Headers, v)
+	}
+
+	i.Request.URL = redactBody(i.Request.URL)
+	i.Request.Body = redactBody(i.Request.Body)
+	for _, v := range i.Request.Form {
+		for j := range v {
+			v[j] = redactBody(v[j])
+		}
+	}
+	i.Response.Body = redactBody(i.Response.Body)
+
+	return nil
+}
+
+func redactBody(body string) string {
+	redactorsLock.RLock()
+	defer redactorsLock.RUnlock()
+
+	for _, r := range redactors {
+		body = r(body)
+	}
+
+	return body
+}
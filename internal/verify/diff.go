@@ -21,12 +21,13 @@ import (
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfigForContext(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	normalizeTagsConfig := meta.(*conns.AWSClient).NormalizeTagsConfig
 
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
-	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+	allTags := defaultTagsConfig.MergeTags(resourceTags).Normalize(normalizeTagsConfig).IgnoreConfig(ignoreTagsConfig)
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when there is a known diff (excluding an empty map)
@@ -41,6 +42,10 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	if err := checkRequiredTags(ctx, meta.(*conns.AWSClient).RequiredTagsConfig, defaultTagsConfig.MergeTags(resourceTags).Normalize(normalizeTagsConfig)); err != nil {
+		return err
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
@@ -156,3 +161,17 @@ func DiffStringMaps(oldMap, newMap map[string]interface{}) (map[string]*string,
 
 	return add, remove, unchanged
 }
+
+// checkRequiredTags returns an error if the merged resource and provider-level tags violate the tagging policy.
+func checkRequiredTags(ctx context.Context, requiredTagsConfig *tftags.RequiredConfig, allTags tftags.KeyValueTags) error {
+	if requiredTagsConfig == nil {
+		return nil
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return requiredTagsConfig.Check(inContext.TypeName, allTags)
+}
@@ -24,5 +24,6 @@ const (
 	ColAllowedSubcategory      = 20
 	ColDeprecatedEnvVar        = 21
 	ColEnvVar                  = 22
-	ColNote                    = 23
+	ColRateLimit               = 23
+	ColNote                    = 24
 )
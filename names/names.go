@@ -17,6 +17,7 @@ import (
 	"encoding/csv"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 )
 
@@ -67,6 +68,7 @@ type ServiceDatum struct {
 	GoV2Package        string
 	HumanFriendly      string
 	ProviderNameUpper  string
+	RateLimit          float64
 }
 
 // serviceData key is the AWS provider service package
@@ -114,6 +116,14 @@ func readCSVIntoServiceData() error {
 			p = l[ColProviderPackageActual]
 		}
 
+		var rateLimit float64
+		if v := l[ColRateLimit]; v != "" {
+			var err error
+			if rateLimit, err = strconv.ParseFloat(v, 64); err != nil {
+				return fmt.Errorf("parsing RateLimit (%s) for %s: %w", v, p, err)
+			}
+		}
+
 		serviceData[p] = &ServiceDatum{
 			Brand:              l[ColBrand],
 			DeprecatedEnvVar:   l[ColDeprecatedEnvVar],
@@ -123,6 +133,7 @@ func readCSVIntoServiceData() error {
 			GoV2Package:        l[ColGoV2Package],
 			HumanFriendly:      l[ColHumanFriendly],
 			ProviderNameUpper:  l[ColProviderNameUpper],
+			RateLimit:          rateLimit,
 		}
 
 		a := []string{p}
@@ -195,6 +206,16 @@ func EnvVar(service string) string {
 	return ""
 }
 
+// RateLimit returns the default maximum number of AWS API requests per second for the service.
+// 0 indicates no limit.
+func RateLimit(service string) float64 {
+	if v, ok := serviceData[service]; ok {
+		return v.RateLimit
+	}
+
+	return 0
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.Brand == "" {
@@ -479,3 +479,55 @@ func TestAWSGoV1ClientName(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected float64
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: 0,
+		},
+		{
+			TestName: Route53,
+			Input:    Route53,
+			Expected: 5,
+		},
+		{
+			TestName: IAM,
+			Input:    IAM,
+			Expected: 10,
+		},
+		{
+			TestName: Organizations,
+			Input:    Organizations,
+			Expected: 5,
+		},
+		{
+			TestName: Transcribe,
+			Input:    Transcribe,
+			Expected: 0,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := RateLimit(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
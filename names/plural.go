@@ -0,0 +1,19 @@
+package names
+
+import (
+	"strings"
+)
+
+// Plural returns the English plural of the (last word of the) name `s`, e.g. "SecurityGroupId" becomes "SecurityGroupIds".
+func Plural(s string) string {
+	lower := strings.ToLower(s)
+
+	switch {
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !strings.ContainsAny(lower[len(lower)-2:len(lower)-1], "aeiou"):
+		return s[:len(s)-1] + "ies"
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return s + "es"
+	default:
+		return s + "s"
+	}
+}
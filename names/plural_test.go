@@ -0,0 +1,57 @@
+package names
+
+import (
+	"testing"
+)
+
+func TestPlural(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "simple",
+			Input:    "TargetGroup",
+			Expected: "TargetGroups",
+		},
+		{
+			TestName: "y",
+			Input:    "Policy",
+			Expected: "Policies",
+		},
+		{
+			TestName: "ey",
+			Input:    "Key",
+			Expected: "Keys",
+		},
+		{
+			TestName: "ay",
+			Input:    "Gateway",
+			Expected: "Gateways",
+		},
+		{
+			TestName: "s",
+			Input:    "Address",
+			Expected: "Addresses",
+		},
+		{
+			TestName: "initialism",
+			Input:    "SecurityGroupId",
+			Expected: "SecurityGroupIds",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := Plural(testCase.Input), testCase.Expected; got != want {
+				t.Errorf("Plural(%q) = %q, want %q", testCase.Input, got, want)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
 	"github.com/spf13/cobra"
 )
@@ -12,12 +14,19 @@ var (
 	force           bool
 	v1              bool
 	pluginFramework bool
+	apiOps          resource.APIOperations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if apiOps.IsSet() {
+			if v1 {
+				return fmt.Errorf("generating from API operations requires AWS Go SDK v2")
+			}
+			return resource.CreateFromAPI(name, snakeName, !clearComments, force, apiOps)
+		}
 		return resource.Create(name, snakeName, !clearComments, force, !v1, pluginFramework)
 	},
 }
@@ -30,4 +39,10 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginFramework, "plugin-framework", "p", false, "generate for Terraform Plugin-Framework")
+	resourceCmd.Flags().StringVar(&apiOps.Service, "sdk-service", "", "AWS Go SDK v2 service package to generate from, if not the service package (e.g., vpclattice)")
+	resourceCmd.Flags().StringVar(&apiOps.Create, "create-op", "", "generate a Plugin-Framework resource from AWS Go SDK v2 API shapes, using this create operation (e.g., CreateServiceNetwork)")
+	resourceCmd.Flags().StringVar(&apiOps.Read, "read-op", "", "read operation for --create-op (e.g., GetServiceNetwork)")
+	resourceCmd.Flags().StringVar(&apiOps.Update, "update-op", "", "optional update operation for --create-op (e.g., UpdateServiceNetwork)")
+	resourceCmd.Flags().StringVar(&apiOps.Delete, "delete-op", "", "delete operation for --create-op (e.g., DeleteServiceNetwork)")
+	resourceCmd.Flags().StringVar(&apiOps.List, "list-op", "", "optional paginated list operation for --create-op's sweeper (e.g., ListServiceNetworks)")
 }
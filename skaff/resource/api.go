@@ -0,0 +1,683 @@
+package resource
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/shape"
+)
+
+//go:embed resourcefwapi.tmpl
+var resourceFrameworkAPITmpl string
+
+//go:embed resourcefwapitest.tmpl
+var resourceFrameworkAPITestTmpl string
+
+//go:embed sweepfwapi.tmpl
+var sweepFrameworkAPITmpl string
+
+// APIOperations are the AWS SDK for Go v2 API operations from which a resource is derived.
+type APIOperations struct {
+	Service string // AWS SDK for Go v2 service package, e.g. "vpclattice". Defaults to the provider service package.
+	Create  string // e.g. "CreateServiceNetwork".
+	Read    string // e.g. "GetServiceNetwork".
+	Update  string // Optional. Without an update operation all arguments force replacement.
+	Delete  string // e.g. "DeleteServiceNetwork".
+	List    string // Optional, used by the sweeper. Guessed from the resource name if not set.
+}
+
+// IsSet returns whether any API operation has been specified.
+func (o APIOperations) IsSet() bool {
+	return o.Create != "" || o.Read != "" || o.Update != "" || o.Delete != ""
+}
+
+// APITemplateData is the template data for a Plugin Framework resource derived from API shapes.
+type APITemplateData struct {
+	TemplateData
+
+	SDKPackage string // AWS SDK for Go v2 service package.
+
+	CreateOp string
+	ReadOp   string
+	UpdateOp string
+	DeleteOp string
+	ListOp   string
+
+	ResourcePlural string // Plural resource name, e.g. "ServiceNetworks".
+
+	CreateResultField string // Create output field holding the new resource, or "" if the output is the resource.
+	CreateIDField     string // Create output (or result) field holding the new resource's identifier.
+	ReadIDField       string // Read input field identifying the resource.
+	UpdateIDField     string // Update input field identifying the resource.
+	DeleteIDField     string // Delete input field identifying the resource.
+	ListItemsField    string // List output field holding the resources.
+	ListItemIDField   string // List output item field holding each resource's identifier.
+
+	ReadResultField string // Read output field holding the resource, or "" if the output is the resource.
+	FindResultType  string // Go type returned by the finder, e.g. "awstypes.ServiceNetwork".
+
+	NotFoundException string // Service's not found exception type.
+
+	HasARN        bool
+	HasTags       bool
+	ResultHasTags bool // Whether the Read output returns the resource's tags.
+
+	StatusField    string   // Resource's status enum field, or "" if the resource has no status.
+	StatusPending  []string // Status enum constants while creating or updating.
+	StatusTarget   []string // Status enum constants once created or updated.
+	StatusDeleting []string // Status enum constants while deleting.
+
+	Attributes    []*APIAttribute // Top-level attributes (including nested blocks), sorted by name.
+	NestedObjects []*APIObject    // Nested objects of read-only attributes, sorted by name.
+	Updatable     []*APIAttribute // Top-level attributes that can be updated in place.
+	RequiredArgs  []*APIAttribute // Top-level required attributes, used in the acceptance test configuration.
+}
+
+// APIAttribute is a resource schema attribute or nested block derived from an API field.
+type APIAttribute struct {
+	GoName        string // Model struct field name, matching the API field for AutoFlex.
+	SchemaKey     string // Schema map key.
+	Schema        string // Schema definition for standard attributes, e.g. "framework.IDAttribute()".
+	TFName        string // Attribute name.
+	SchemaType    string // e.g. "schema.StringAttribute" or "schema.ListNestedBlock".
+	ModelType     string // e.g. "types.String".
+	AttrType      string // e.g. "types.StringType", used in nested objects' attribute types.
+	ElementType   string // Element type of list and map attributes.
+	CustomType    string // Custom attribute type, if any.
+	PlanModifier  string // Plan modifier package, e.g. "stringplanmodifier".
+	PlanModifiers string // Plan modifier type, e.g. "planmodifier.String".
+	Enum          string // Enum type name, if the attribute's values are constrained.
+
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+	Block           bool // Nested block rather than attribute.
+	MaxItems1       bool // Nested block with at most one element.
+
+	Nested *APIObject // Nested object, if any.
+}
+
+// APIObject is a nested object derived from an API structure.
+type APIObject struct {
+	Name       string // Unexported Go identifier prefix, e.g. "listenerConfig".
+	Attributes []*APIAttribute
+}
+
+// Blocks returns the object's nested blocks.
+func (o *APIObject) Blocks() []*APIAttribute {
+	return blocks(o.Attributes)
+}
+
+// NonBlocks returns the object's attributes that are not nested blocks.
+func (o *APIObject) NonBlocks() []*APIAttribute {
+	return nonBlocks(o.Attributes)
+}
+
+// CanUpdate returns whether the resource has any arguments that can be updated in place.
+func (d *APITemplateData) CanUpdate() bool {
+	return d.UpdateOp != "" && len(d.Updatable) > 0
+}
+
+// HasRequiredStringArg returns whether the resource has any top-level required string arguments.
+func (d *APITemplateData) HasRequiredStringArg() bool {
+	for _, a := range d.RequiredArgs {
+		if a.ModelType == "types.String" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Blocks returns the resource's top-level nested blocks.
+func (d *APITemplateData) Blocks() []*APIAttribute {
+	return blocks(d.Attributes)
+}
+
+// NonBlocks returns the resource's top-level attributes that are not nested blocks.
+func (d *APITemplateData) NonBlocks() []*APIAttribute {
+	return nonBlocks(d.Attributes)
+}
+
+func blocks(attributes []*APIAttribute) []*APIAttribute {
+	var v []*APIAttribute
+
+	for _, a := range attributes {
+		if a.Block {
+			v = append(v, a)
+		}
+	}
+
+	return v
+}
+
+func nonBlocks(attributes []*APIAttribute) []*APIAttribute {
+	var v []*APIAttribute
+
+	for _, a := range attributes {
+		if !a.Block {
+			v = append(v, a)
+		}
+	}
+
+	return v
+}
+
+// HasPlanModifiers returns whether the attribute's schema has any plan modifiers.
+func (a *APIAttribute) HasPlanModifiers() bool {
+	return a.Schema == "" && (a.RequiresReplace || (a.Computed && !a.Block))
+}
+
+// HasValidators returns whether the attribute's schema has any validators.
+func (a *APIAttribute) HasValidators() bool {
+	return a.Enum != "" || (a.Block && (a.MaxItems1 || a.Required))
+}
+
+// Imports returns the import paths used by the generated resource, standard library imports first.
+func (d *APITemplateData) Imports() [][]string {
+	std := map[string]bool{
+		"context": true,
+	}
+	other := map[string]bool{
+		"github.com/aws/aws-sdk-go-v2/aws":                                                    true,
+		"github.com/aws/aws-sdk-go-v2/service/" + d.SDKPackage:                                true,
+		"github.com/hashicorp/terraform-plugin-framework/path":                                true,
+		"github.com/hashicorp/terraform-plugin-framework/resource":                            true,
+		"github.com/hashicorp/terraform-plugin-framework/resource/schema":                     true,
+		"github.com/hashicorp/terraform-plugin-framework/types":                               true,
+		"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry":                           true,
+		"github.com/hashicorp/terraform-provider-aws/internal/create":                         true,
+		"github.com/hashicorp/terraform-provider-aws/internal/errs":                           true,
+		"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag":                    true,
+		"github.com/hashicorp/terraform-provider-aws/internal/framework":                      true,
+		"github.com/hashicorp/terraform-provider-aws/internal/framework/flex":                 true,
+		"github.com/hashicorp/terraform-provider-aws/internal/tfresource":                     true,
+		"github.com/hashicorp/terraform-provider-aws/names":                                   true,
+		fmt.Sprintf(`awstypes "github.com/aws/aws-sdk-go-v2/service/%s/types"`, d.SDKPackage): true,
+	}
+
+	if d.StatusField != "" {
+		std["time"] = true
+		other["github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"] = true
+		other["github.com/hashicorp/terraform-provider-aws/internal/enum"] = true
+	}
+	if d.HasTags {
+		other[`tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"`] = true
+	}
+	if len(d.NestedObjects) > 0 {
+		other["github.com/hashicorp/terraform-plugin-framework/attr"] = true
+	}
+
+	var walk func([]*APIAttribute)
+	walk = func(attributes []*APIAttribute) {
+		for _, a := range attributes {
+			if a.HasPlanModifiers() {
+				other["github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"] = true
+				other["github.com/hashicorp/terraform-plugin-framework/resource/schema/"+a.PlanModifier] = true
+			}
+			if a.HasValidators() {
+				other["github.com/hashicorp/terraform-plugin-framework/schema/validator"] = true
+			}
+			if a.Enum != "" {
+				other["github.com/hashicorp/terraform-provider-aws/internal/enum"] = true
+			}
+			if a.Block && (a.MaxItems1 || a.Required) {
+				other["github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"] = true
+			}
+			if a.CustomType != "" {
+				other[`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`] = true
+			}
+			if a.Nested != nil && a.Block {
+				walk(a.Nested.Attributes)
+			}
+		}
+	}
+	walk(d.Attributes)
+
+	// Nested objects' attribute types may reference custom types.
+	for _, o := range d.NestedObjects {
+		for _, a := range o.Attributes {
+			if a.CustomType != "" {
+				other[`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`] = true
+			}
+		}
+	}
+
+	return [][]string{importPaths(std), importPaths(other)}
+}
+
+// importPaths returns the sorted, quoted import paths in `m`. Named imports are already quoted.
+func importPaths(m map[string]bool) []string {
+	var v []string
+
+	for _, path := range sortedKeys(m) {
+		if !strings.HasSuffix(path, `"`) {
+			path = fmt.Sprintf("%q", path)
+		}
+		v = append(v, path)
+	}
+
+	// Sort by path, ignoring any import name.
+	sort.SliceStable(v, func(i, j int) bool {
+		return strings.Trim(v[i][strings.Index(v[i], `"`):], `"`) < strings.Trim(v[j][strings.Index(v[j], `"`):], `"`)
+	})
+
+	return v
+}
+
+// attributeMode is how an API field is used in a resource's schema.
+type attributeMode int
+
+const (
+	modeArgument attributeMode = iota // Configurable by the user.
+	modeComputed                      // Read-only.
+)
+
+// newAPITemplateData derives a resource's template data from its API operations' shapes.
+func newAPITemplateData(td TemplateData, svc *shape.Service, ops APIOperations) (*APITemplateData, error) {
+	if ops.Create == "" || ops.Read == "" || ops.Delete == "" {
+		return nil, fmt.Errorf("create, read and delete operations are required")
+	}
+
+	data := &APITemplateData{
+		TemplateData: td,
+		SDKPackage:   svc.Package,
+		CreateOp:     ops.Create,
+		ReadOp:       ops.Read,
+		UpdateOp:     ops.Update,
+		DeleteOp:     ops.Delete,
+	}
+
+	createIn, createOut, err := svc.Operation(ops.Create)
+	if err != nil {
+		return nil, err
+	}
+
+	readIn, readOut, err := svc.Operation(ops.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteIn, _, err := svc.Operation(ops.Delete)
+	if err != nil {
+		return nil, err
+	}
+
+	var updateIn *shape.Struct
+	if ops.Update != "" {
+		if updateIn, _, err = svc.Operation(ops.Update); err != nil {
+			return nil, err
+		}
+	}
+
+	// The resource is either the Read output itself or an output field named for the resource.
+	resource := readOut
+	data.FindResultType = fmt.Sprintf("%s.%sOutput", svc.Package, ops.Read)
+	if f := resultField(readOut, td.Resource); f != nil {
+		resource = f.Struct
+		data.ReadResultField = f.Name
+		data.FindResultType = "awstypes." + f.Struct.Name
+	}
+
+	if data.ReadIDField = identifierField(readIn); data.ReadIDField == "" {
+		return nil, fmt.Errorf("operation (%s): no identifier field found", ops.Read)
+	}
+	if data.DeleteIDField = identifierField(deleteIn); data.DeleteIDField == "" {
+		return nil, fmt.Errorf("operation (%s): no identifier field found", ops.Delete)
+	}
+	if updateIn != nil {
+		if data.UpdateIDField = identifierField(updateIn); data.UpdateIDField == "" {
+			return nil, fmt.Errorf("operation (%s): no identifier field found", ops.Update)
+		}
+	}
+
+	if f := resultField(createOut, td.Resource); f != nil {
+		data.CreateResultField = f.Name
+		data.CreateIDField = outputIdentifierField(f.Struct, td.Resource)
+	} else {
+		data.CreateIDField = outputIdentifierField(createOut, td.Resource)
+	}
+	if data.CreateIDField == "" {
+		return nil, fmt.Errorf("operation (%s): no identifier field found", ops.Create)
+	}
+
+	data.NotFoundException = notFoundException(svc)
+	data.HasARN = resource.Field("Arn") != nil
+	data.HasTags = createIn.Field("Tags") != nil && createIn.Field("Tags").Kind == shape.KindStringMap
+	data.ResultHasTags = data.HasTags && resource.Field("Tags") != nil && resource.Field("Tags").Kind == shape.KindStringMap
+
+	objects := make(map[string]*APIObject)
+	seen := make(map[string]bool)
+
+	if data.HasARN {
+		data.Attributes = append(data.Attributes, newStandardAPIAttribute("ARN", "arn", "types.String", `"arn"`, "framework.ARNAttributeComputedOnly()"))
+	}
+	data.Attributes = append(data.Attributes, newStandardAPIAttribute("ID", "id", "types.String", `"id"`, "framework.IDAttribute()"))
+	if data.HasTags {
+		data.Attributes = append(data.Attributes,
+			newStandardAPIAttribute("Tags", "tags", "types.Map", "names.AttrTags", "tftags.TagsAttribute()"),
+			newStandardAPIAttribute("TagsAll", "tags_all", "types.Map", "names.AttrTagsAll", "tftags.TagsAttributeComputedOnly()"),
+		)
+	}
+
+	for _, f := range createIn.Fields {
+		if f.Name == "Tags" {
+			continue
+		}
+
+		a := newAPIAttribute(f, modeArgument, objects)
+		a.Required = f.Required
+		a.Optional = !f.Required
+
+		if updateIn == nil || updateIn.Field(f.Name) == nil {
+			a.RequiresReplace = true
+		} else {
+			data.Updatable = append(data.Updatable, a)
+		}
+
+		// Optional arguments whose value is returned by the API may be defaulted by AWS.
+		if a.Optional && !a.Block && resource.Field(f.Name) != nil {
+			a.Computed = true
+		}
+
+		if a.Required {
+			data.RequiredArgs = append(data.RequiredArgs, a)
+		}
+
+		data.Attributes = append(data.Attributes, a)
+		seen[f.Name] = true
+	}
+
+	for _, f := range resource.Fields {
+		if seen[f.Name] || f.Name == "Tags" {
+			continue
+		}
+
+		switch f.Name {
+		case "Arn", "Id":
+			// Standard attributes.
+			continue
+		}
+
+		if f.Name == "Status" && f.Kind == shape.KindEnum {
+			data.StatusField = f.Name
+			data.StatusPending, data.StatusTarget, data.StatusDeleting = classifyStatuses(svc, f.Enum)
+		}
+
+		data.Attributes = append(data.Attributes, newAPIAttribute(f, modeComputed, objects))
+	}
+
+	sortAttributes(data.Attributes)
+	for _, name := range sortedKeys(objects) {
+		data.NestedObjects = append(data.NestedObjects, objects[name])
+	}
+
+	// Without both pending and target statuses, there's nothing to wait for.
+	if len(data.StatusPending) == 0 || len(data.StatusTarget) == 0 {
+		data.StatusField = ""
+	}
+
+	data.ResourcePlural = names.Plural(td.Resource)
+	data.ListOp = ops.List
+	if data.ListOp == "" {
+		data.ListOp = "List" + data.ResourcePlural
+	}
+	if svc.HasPaginator(data.ListOp) {
+		if _, listOut, err := svc.Operation(data.ListOp); err == nil {
+			for _, f := range listOut.Fields {
+				if f.Kind == shape.KindStructList {
+					data.ListItemsField = f.Name
+					data.ListItemIDField = outputIdentifierField(f.Struct, td.Resource)
+					break
+				}
+			}
+		}
+	}
+	if data.ListItemsField == "" || data.ListItemIDField == "" {
+		data.ListOp = ""
+	}
+
+	return data, nil
+}
+
+// newStandardAPIAttribute returns a standard attribute, such as `id`, with a predefined schema.
+func newStandardAPIAttribute(goName, tfName, modelType, schemaKey, schema string) *APIAttribute {
+	return &APIAttribute{
+		GoName:    goName,
+		TFName:    tfName,
+		ModelType: modelType,
+		SchemaKey: schemaKey,
+		Schema:    schema,
+	}
+}
+
+// newAPIAttribute returns the attribute corresponding to API field `f`.
+// Nested objects are recorded in `objects`.
+func newAPIAttribute(f *shape.Field, mode attributeMode, objects map[string]*APIObject) *APIAttribute {
+	a := &APIAttribute{
+		GoName:   modelFieldName(f.Name),
+		TFName:   ToSnakeCase(f.Name, ""),
+		Computed: mode == modeComputed,
+	}
+	a.SchemaKey = fmt.Sprintf("%q", a.TFName)
+
+	switch f.Kind {
+	case shape.KindBool:
+		a.SchemaType, a.ModelType, a.AttrType = "schema.BoolAttribute", "types.Bool", "types.BoolType"
+		a.PlanModifier, a.PlanModifiers = "boolplanmodifier", "planmodifier.Bool"
+
+	case shape.KindInt64:
+		a.SchemaType, a.ModelType, a.AttrType = "schema.Int64Attribute", "types.Int64", "types.Int64Type"
+		a.PlanModifier, a.PlanModifiers = "int64planmodifier", "planmodifier.Int64"
+
+	case shape.KindFloat64:
+		a.SchemaType, a.ModelType, a.AttrType = "schema.Float64Attribute", "types.Float64", "types.Float64Type"
+		a.PlanModifier, a.PlanModifiers = "float64planmodifier", "planmodifier.Float64"
+
+	case shape.KindString, shape.KindEnum:
+		a.SchemaType, a.ModelType, a.AttrType = "schema.StringAttribute", "types.String", "types.StringType"
+		a.PlanModifier, a.PlanModifiers = "stringplanmodifier", "planmodifier.String"
+		if mode == modeArgument {
+			a.Enum = f.Enum
+		}
+
+	case shape.KindTimestamp:
+		a.SchemaType, a.ModelType, a.AttrType = "schema.StringAttribute", "fwtypes.TimestampValue", "fwtypes.TimestampType{}"
+		a.CustomType = "fwtypes.TimestampType{}"
+		a.PlanModifier, a.PlanModifiers = "stringplanmodifier", "planmodifier.String"
+
+	case shape.KindStringList:
+		a.SchemaType, a.ModelType, a.AttrType = "schema.ListAttribute", "types.List", "types.ListType{ElemType: types.StringType}"
+		a.ElementType = "types.StringType"
+		a.PlanModifier, a.PlanModifiers = "listplanmodifier", "planmodifier.List"
+
+	case shape.KindStringMap:
+		a.SchemaType, a.ModelType, a.AttrType = "schema.MapAttribute", "types.Map", "types.MapType{ElemType: types.StringType}"
+		a.ElementType = "types.StringType"
+		a.PlanModifier, a.PlanModifiers = "mapplanmodifier", "planmodifier.Map"
+
+	case shape.KindStruct, shape.KindStructList:
+		a.Nested = newAPIObject(f.Struct, mode, objects)
+		a.ModelType = "types.List"
+		a.AttrType = fmt.Sprintf("types.ListType{ElemType: types.ObjectType{AttrTypes: %sAttrTypes}}", a.Nested.Name)
+		a.ElementType = fmt.Sprintf("types.ObjectType{AttrTypes: %sAttrTypes}", a.Nested.Name)
+		a.PlanModifier, a.PlanModifiers = "listplanmodifier", "planmodifier.List"
+
+		// Only arguments can be blocks; read-only nested objects are list attributes.
+		if mode == modeArgument {
+			a.SchemaType = "schema.ListNestedBlock"
+			a.Block = true
+			a.MaxItems1 = f.Kind == shape.KindStruct
+		} else {
+			a.SchemaType = "schema.ListAttribute"
+		}
+	}
+
+	return a
+}
+
+// newAPIObject returns the nested object corresponding to API structure `s`.
+// Read-only nested objects are recorded in `objects` as their attribute types are referenced by name.
+func newAPIObject(s *shape.Struct, mode attributeMode, objects map[string]*APIObject) *APIObject {
+	name := lowerFirst(s.Name)
+
+	if o, ok := objects[name]; ok && mode == modeComputed {
+		return o
+	}
+
+	o := &APIObject{Name: name}
+	if mode == modeComputed {
+		objects[name] = o
+	}
+
+	for _, f := range s.Fields {
+		a := newAPIAttribute(f, mode, objects)
+		if mode == modeArgument {
+			a.Required = f.Required
+			a.Optional = !f.Required
+		}
+		o.Attributes = append(o.Attributes, a)
+	}
+
+	sortAttributes(o.Attributes)
+
+	return o
+}
+
+// resultField returns the output field holding the resource structure, or nil if there is none.
+func resultField(out *shape.Struct, resName string) *shape.Field {
+	if f := out.Field(resName); f != nil && f.Kind == shape.KindStruct {
+		return f
+	}
+
+	return nil
+}
+
+// identifierField returns the name of the input field identifying a resource, or "" if there is none.
+func identifierField(in *shape.Struct) string {
+	var candidates []string
+
+	for _, f := range in.Fields {
+		if f.Required && f.Kind == shape.KindString {
+			candidates = append(candidates, f.Name)
+		}
+	}
+
+	for _, suffix := range []string{"Identifier", "Id", "Arn", "Name"} {
+		for _, name := range candidates {
+			if strings.HasSuffix(name, suffix) {
+				return name
+			}
+		}
+	}
+
+	if len(candidates) > 0 {
+		return candidates[0]
+	}
+
+	return ""
+}
+
+// outputIdentifierField returns the name of the output field holding a resource's identifier, or "" if there is none.
+func outputIdentifierField(out *shape.Struct, resName string) string {
+	for _, name := range []string{resName + "Id", "Id", resName + "Arn", "Arn", resName + "Name", "Name"} {
+		if f := out.Field(name); f != nil && f.Kind == shape.KindString {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// notFoundException returns the name of the service's not found exception type.
+func notFoundException(svc *shape.Service) string {
+	for _, name := range []string{"ResourceNotFoundException", "NotFoundException"} {
+		if svc.HasType(name) {
+			return name
+		}
+	}
+
+	return "ResourceNotFoundException"
+}
+
+// classifyStatuses splits the values of a resource's status enum into pending, target (created or updated) and deleting statuses.
+// Failed statuses are in none of them.
+func classifyStatuses(svc *shape.Service, enum string) (pending, target, deleting []string) {
+	for _, v := range svc.EnumValues(enum) {
+		value := strings.ToUpper(v.Value)
+		constant := "awstypes." + v.Const
+
+		switch {
+		case strings.Contains(value, "FAIL"):
+		case strings.Contains(value, "DELET"):
+			deleting = append(deleting, constant)
+		case containsAny(value, "PROGRESS", "PENDING", "CREATING", "UPDATING", "PROVISIONING", "MODIFYING"):
+			pending = append(pending, constant)
+		case containsAny(value, "ACTIVE", "AVAILABLE", "READY", "CREATED", "UPDATED", "ENABLED", "SUCCEEDED", "COMPLETE", "RUNNING"):
+			if !strings.HasPrefix(value, "INACTIVE") {
+				target = append(target, constant)
+			}
+		}
+	}
+
+	return pending, target, deleting
+}
+
+func containsAny(s string, substrs ...string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// modelFieldName returns the model struct field name for API field `name`.
+// Field names match API field names so that AutoFlex can copy values, except for common initialisms.
+func modelFieldName(name string) string {
+	switch name {
+	case "Arn":
+		return "ARN"
+	case "Id":
+		return "ID"
+	}
+
+	return name
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	// Lower-case any leading initialism, e.g. "VPCConfig" becomes "vpcConfig".
+	i := 1
+	for i < len(s) && strings.ToUpper(s[i:i+1]) == s[i:i+1] && (i+1 == len(s) || strings.ToUpper(s[i+1:i+2]) == s[i+1:i+2]) {
+		i++
+	}
+
+	return strings.ToLower(s[:i]) + s[i:]
+}
+
+func sortAttributes(attributes []*APIAttribute) {
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].TFName < attributes[j].TFName
+	})
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
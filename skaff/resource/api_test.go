@@ -0,0 +1,239 @@
+package resource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/shape"
+)
+
+func TestLowerFirst(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			TestName: "simple",
+			Input:    "Config",
+			Expected: "config",
+		},
+		{
+			TestName: "initialism",
+			Input:    "ID",
+			Expected: "id",
+		},
+		{
+			TestName: "leading initialism",
+			Input:    "VPCConfig",
+			Expected: "vpcConfig",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := lowerFirst(testCase.Input)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestNewAPITemplateData(t *testing.T) {
+	svc, err := shape.Load("../shape/testdata/widgets")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	type summary struct {
+		ReadResultField   string
+		FindResultType    string
+		CreateIDField     string
+		ReadIDField       string
+		UpdateIDField     string
+		DeleteIDField     string
+		ListOp            string
+		ListItemsField    string
+		ListItemIDField   string
+		NotFoundException string
+		HasARN            bool
+		HasTags           bool
+		ResultHasTags     bool
+		StatusField       string
+		StatusPending     []string
+		StatusTarget      []string
+		StatusDeleting    []string
+		Attributes        []string
+		Updatable         []string
+		RequiredArgs      []string
+		RequiresReplace   []string
+	}
+
+	testCases := []struct {
+		TestName    string
+		Operations  APIOperations
+		Expected    summary
+		ExpectError bool
+	}{
+		{
+			TestName: "updatable",
+			Operations: APIOperations{
+				Create: "CreateWidget",
+				Read:   "GetWidget",
+				Update: "UpdateWidget",
+				Delete: "DeleteWidget",
+			},
+			Expected: summary{
+				ReadResultField:   "Widget",
+				FindResultType:    "awstypes.Widget",
+				CreateIDField:     "Id",
+				ReadIDField:       "WidgetIdentifier",
+				UpdateIDField:     "WidgetIdentifier",
+				DeleteIDField:     "WidgetIdentifier",
+				ListOp:            "ListWidgets",
+				ListItemsField:    "Items",
+				ListItemIDField:   "Id",
+				NotFoundException: "ResourceNotFoundException",
+				HasARN:            true,
+				HasTags:           true,
+				ResultHasTags:     true,
+				StatusField:       "Status",
+				StatusPending:     []string{"awstypes.WidgetStatusCreating"},
+				StatusTarget:      []string{"awstypes.WidgetStatusActive"},
+				StatusDeleting:    []string{"awstypes.WidgetStatusDeleting"},
+				Attributes:        []string{"arn", "configuration", "created_at", "description", "id", "name", "size", "status", "tags", "tags_all"},
+				Updatable:         []string{"description"},
+				RequiredArgs:      []string{"name"},
+				RequiresReplace:   []string{"configuration", "name", "size"},
+			},
+		},
+		{
+			TestName: "replace only",
+			Operations: APIOperations{
+				Create: "CreateWidget",
+				Read:   "GetWidget",
+				Delete: "DeleteWidget",
+				List:   "ListGadgets",
+			},
+			Expected: summary{
+				ReadResultField:   "Widget",
+				FindResultType:    "awstypes.Widget",
+				CreateIDField:     "Id",
+				ReadIDField:       "WidgetIdentifier",
+				DeleteIDField:     "WidgetIdentifier",
+				NotFoundException: "ResourceNotFoundException",
+				HasARN:            true,
+				HasTags:           true,
+				ResultHasTags:     true,
+				StatusField:       "Status",
+				StatusPending:     []string{"awstypes.WidgetStatusCreating"},
+				StatusTarget:      []string{"awstypes.WidgetStatusActive"},
+				StatusDeleting:    []string{"awstypes.WidgetStatusDeleting"},
+				Attributes:        []string{"arn", "configuration", "created_at", "description", "id", "name", "size", "status", "tags", "tags_all"},
+				RequiredArgs:      []string{"name"},
+				RequiresReplace:   []string{"configuration", "description", "name", "size"},
+			},
+		},
+		{
+			TestName: "missing operation",
+			Operations: APIOperations{
+				Create: "CreateWidget",
+				Delete: "DeleteWidget",
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "unknown operation",
+			Operations: APIOperations{
+				Create: "CreateWidget",
+				Read:   "DescribeWidget",
+				Delete: "DeleteWidget",
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "no identifier",
+			Operations: APIOperations{
+				Create: "CreateWidget",
+				Read:   "GetWidget",
+				Delete: "ListWidgets",
+			},
+			ExpectError: true,
+		},
+	}
+
+	tfNames := func(attributes []*APIAttribute) []string {
+		var v []string
+		for _, a := range attributes {
+			v = append(v, a.TFName)
+		}
+		return v
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			data, err := newAPITemplateData(TemplateData{Resource: "Widget"}, svc, testCase.Operations)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var requiresReplace []string
+			for _, a := range data.Attributes {
+				if a.RequiresReplace {
+					requiresReplace = append(requiresReplace, a.TFName)
+				}
+			}
+
+			got := summary{
+				ReadResultField:   data.ReadResultField,
+				FindResultType:    data.FindResultType,
+				CreateIDField:     data.CreateIDField,
+				ReadIDField:       data.ReadIDField,
+				UpdateIDField:     data.UpdateIDField,
+				DeleteIDField:     data.DeleteIDField,
+				ListOp:            data.ListOp,
+				ListItemsField:    data.ListItemsField,
+				ListItemIDField:   data.ListItemIDField,
+				NotFoundException: data.NotFoundException,
+				HasARN:            data.HasARN,
+				HasTags:           data.HasTags,
+				ResultHasTags:     data.ResultHasTags,
+				StatusField:       data.StatusField,
+				StatusPending:     data.StatusPending,
+				StatusTarget:      data.StatusTarget,
+				StatusDeleting:    data.StatusDeleting,
+				Attributes:        tfNames(data.Attributes),
+				Updatable:         tfNames(data.Updatable),
+				RequiredArgs:      tfNames(data.RequiredArgs),
+				RequiresReplace:   requiresReplace,
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %+v, expected %+v", got, testCase.Expected)
+			}
+
+			if got, want := data.SDKPackage, "widgets"; got != want {
+				t.Errorf("got SDK package %s, expected %s", got, want)
+			}
+
+			if got, want := data.ResourcePlural, "Widgets"; got != want {
+				t.Errorf("got resource plural %s, expected %s", got, want)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"go/format"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -13,6 +14,7 @@ import (
 	"text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/shape"
 )
 
 //go:embed resource.tmpl
@@ -48,6 +50,10 @@ func ToSnakeCase(upper string, snakeName string) string {
 		return snakeName
 	}
 
+	// Treat plural initialisms (e.g. "VPCs") as a single word.
+	re0 := regexp.MustCompile(`([A-Z]{2,})s([A-Z]|$)`)
+	upper = re0.ReplaceAllString(upper, `${1}S${2}`)
+
 	re := regexp.MustCompile(`([a-z])([A-Z]{2,})`)
 	upper = re.ReplaceAllString(upper, `${1}_${2}`)
 
@@ -67,41 +73,41 @@ func ProviderResourceName(servicePackage, snakeName string) string {
 	return fmt.Sprintf("aws_%s_%s", servicePackage, snakeName)
 }
 
-func Create(resName, snakeName string, comments, force, v2, pluginFramework bool) error {
+func newTemplateData(resName, snakeName string, comments, v2, pluginFramework bool) (TemplateData, error) {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
-		return fmt.Errorf("error reading working directory: %s", err)
+		return TemplateData{}, fmt.Errorf("error reading working directory: %s", err)
 	}
 
 	servicePackage := filepath.Base(wd)
 
 	if resName == "" {
-		return fmt.Errorf("error checking: no name given")
+		return TemplateData{}, fmt.Errorf("error checking: no name given")
 	}
 
 	if resName == strings.ToLower(resName) {
-		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+		return TemplateData{}, fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
 	}
 
 	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
-		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+		return TemplateData{}, fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
 	snakeName = ToSnakeCase(resName, snakeName)
 
 	s, err := names.ProviderNameUpper(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting service connection name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting service connection name: %w", err)
 	}
 
 	sn, err := names.FullHumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting AWS service name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting AWS service name: %w", err)
 	}
 
 	hf, err := names.HumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting human-friendly name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting human-friendly name: %w", err)
 	}
 
 	templateData := TemplateData{
@@ -120,6 +126,18 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework bool
 		ProviderResourceName: ProviderResourceName(servicePackage, snakeName),
 	}
 
+	return templateData, nil
+}
+
+func Create(resName, snakeName string, comments, force, v2, pluginFramework bool) error {
+	templateData, err := newTemplateData(resName, snakeName, comments, v2, pluginFramework)
+	if err != nil {
+		return err
+	}
+
+	servicePackage := templateData.ServicePackage
+	snakeName = templateData.ResourceSnake
+
 	tmpl := resourceTmpl
 	if pluginFramework {
 		tmpl = resourceFrameworkTmpl
@@ -143,34 +161,112 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework bool
 	return nil
 }
 
-func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
-	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
-		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+// CreateFromAPI creates a Plugin Framework resource, its sweeper and an acceptance test skeleton
+// derived from the shapes of the resource's AWS SDK for Go v2 API operations.
+func CreateFromAPI(resName, snakeName string, comments, force bool, ops APIOperations) error {
+	td, err := newTemplateData(resName, snakeName, comments, true, true)
+	if err != nil {
+		return err
 	}
 
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	sdkPackage := ops.Service
+	if sdkPackage == "" {
+		sdkPackage = td.ServicePackage
+	}
+
+	dir, err := shape.ModuleDir(sdkPackage)
 	if err != nil {
-		return fmt.Errorf("error opening file (%s): %s", filename, err)
+		return err
 	}
 
+	svc, err := shape.Load(dir)
+	if err != nil {
+		return fmt.Errorf("loading AWS SDK for Go v2 service (%s): %w", sdkPackage, err)
+	}
+
+	templateData, err := newAPITemplateData(td, svc, ops)
+	if err != nil {
+		return fmt.Errorf("deriving resource from AWS SDK for Go v2 service (%s): %w", sdkPackage, err)
+	}
+
+	f := fmt.Sprintf("%s.go", td.ResourceSnake)
+	if err = writeGoTemplate("newres", f, resourceFrameworkAPITmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", td.ResourceSnake)
+	if err = writeGoTemplate("restest", tf, resourceFrameworkAPITestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	if templateData.ListOp == "" {
+		fmt.Fprintf(os.Stderr, "no paginated list operation found for %s, skipping sweeper\n", td.Resource)
+	} else {
+		sf := fmt.Sprintf("%s_sweep.go", td.ResourceSnake)
+		if err = writeGoTemplate("sweep", sf, sweepFrameworkAPITmpl, force, templateData); err != nil {
+			return fmt.Errorf("writing resource sweeper template: %w", err)
+		}
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", td.ServicePackage, td.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td any) error {
+	contents, err := executeTemplate(templateName, tmpl, td)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(filename, contents, force)
+}
+
+// writeGoTemplate is like writeTemplate, but formats the generated Go source.
+func writeGoTemplate(templateName, filename, tmpl string, force bool, td any) error {
+	contents, err := executeTemplate(templateName, tmpl, td)
+	if err != nil {
+		return err
+	}
+
+	formatted, err := format.Source(contents)
+	if err != nil {
+		return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+	}
+
+	return writeFile(filename, formatted, force)
+}
+
+func executeTemplate(templateName, tmpl string, td any) ([]byte, error) {
 	tplate, err := template.New(templateName).Parse(tmpl)
 	if err != nil {
-		return fmt.Errorf("error parsing template: %s", err)
+		return nil, fmt.Errorf("error parsing template: %s", err)
 	}
 
 	var buffer bytes.Buffer
 	err = tplate.Execute(&buffer, td)
 	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
+		return nil, fmt.Errorf("error executing template: %s", err)
 	}
 
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
+	return buffer.Bytes(), nil
+}
+
+func writeFile(filename string, contents []byte, force bool) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
 
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
@@ -40,6 +40,21 @@ func TestToSnakeName(t *testing.T) {
 			Input:    "DBInstanceVPCEndpoint",
 			Expected: "db_instance_vpc_endpoint",
 		},
+		{
+			TestName: "plural initialism",
+			Input:    "NumberOfAssociatedVPCs",
+			Expected: "number_of_associated_vpcs",
+		},
+		{
+			TestName: "plural initialisms",
+			Input:    "RoleARNsAndIDs",
+			Expected: "role_arns_and_ids",
+		},
+		{
+			TestName: "plural initialism word",
+			Input:    "DBsInstance",
+			Expected: "dbs_instance",
+		},
 	}
 
 	for _, testCase := range testCases {
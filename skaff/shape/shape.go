@@ -0,0 +1,366 @@
+// Package shape introspects the API shapes (input, output and nested structures)
+// of an AWS SDK for Go v2 service package by parsing its source code.
+package shape
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// maxDepth limits how deeply nested structures are resolved, guarding against recursive shapes.
+const maxDepth = 4
+
+// Kind is the kind of an API structure's field.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindBool
+	KindInt64
+	KindFloat64
+	KindString
+	KindEnum
+	KindTimestamp
+	KindStringList
+	KindStringMap
+	KindStruct
+	KindStructList
+)
+
+// Field is an exported field of an API structure.
+type Field struct {
+	Name     string  // Go field name, e.g. "ServiceNetworkId".
+	Kind     Kind    // Field kind.
+	Enum     string  // Enum type name in the service's types package, for KindEnum and enum-valued KindStringList.
+	Struct   *Struct // Nested structure, for KindStruct and KindStructList.
+	Required bool    // Whether the field is documented as required.
+}
+
+// Struct is an API structure.
+type Struct struct {
+	Name   string   // Go type name, e.g. "CreateServiceNetworkInput" or "ServiceNetworkSummary".
+	Fields []*Field // Supported fields, in declaration order.
+}
+
+// Field returns the field with the specified name, or nil if there is no such field.
+func (s *Struct) Field(name string) *Field {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// EnumValue is a value of an API enum type.
+type EnumValue struct {
+	Const string // Go constant name, e.g. "ServiceNetworkStatusActive".
+	Value string // Enum value, e.g. "ACTIVE".
+}
+
+// Service is a parsed AWS SDK for Go v2 service package.
+type Service struct {
+	Package string                   // Go package name, e.g. "vpclattice".
+	structs map[string]*ast.TypeSpec // Service package types, e.g. operation inputs and outputs.
+	types   map[string]*ast.TypeSpec // Types package types, e.g. nested structures and enums.
+	enums   map[string][]EnumValue   // Enum values by enum type name.
+}
+
+// ModuleDir returns the on-disk directory of the AWS SDK for Go v2 service module `sdkPackage`
+// (e.g. "vpclattice") as resolved by the Go module in the current working directory.
+func ModuleDir(sdkPackage string) (string, error) {
+	module := "github.com/aws/aws-sdk-go-v2/service/" + sdkPackage
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", module)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("locating module (%s): %w: %s", module, err, strings.TrimSpace(stderr.String()))
+	}
+
+	dir := strings.TrimSpace(stdout.String())
+	if dir == "" {
+		return "", fmt.Errorf("locating module (%s): not downloaded, run 'go mod download %s'", module, module)
+	}
+
+	return dir, nil
+}
+
+// Load parses the AWS SDK for Go v2 service package source in `dir`.
+func Load(dir string) (*Service, error) {
+	fset := token.NewFileSet()
+
+	svcPkg, err := parsePackage(fset, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	typesPkg, err := parsePackage(fset, filepath.Join(dir, "types"))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Service{
+		Package: svcPkg.name,
+		structs: svcPkg.typeSpecs,
+		types:   typesPkg.typeSpecs,
+		enums:   typesPkg.enums,
+	}
+
+	return s, nil
+}
+
+// Operation returns the input and output structures of the API operation `name` (e.g. "CreateServiceNetwork").
+func (s *Service) Operation(name string) (*Struct, *Struct, error) {
+	in, err := s.resolveStruct(s.structs, name+"Input", 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("operation (%s): %w", name, err)
+	}
+
+	out, err := s.resolveStruct(s.structs, name+"Output", 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("operation (%s): %w", name, err)
+	}
+
+	return in, out, nil
+}
+
+// EnumValues returns the values of the enum type `name`, in declaration order.
+func (s *Service) EnumValues(name string) []EnumValue {
+	return s.enums[name]
+}
+
+// HasType returns whether the service's types package declares the type `name`.
+func (s *Service) HasType(name string) bool {
+	_, ok := s.types[name]
+
+	return ok
+}
+
+// HasPaginator returns whether the service package declares a paginator for the API operation `name`.
+func (s *Service) HasPaginator(name string) bool {
+	_, ok := s.structs[name+"Paginator"]
+
+	return ok
+}
+
+func (s *Service) resolveStruct(specs map[string]*ast.TypeSpec, name string, depth int) (*Struct, error) {
+	spec, ok := specs[name]
+	if !ok {
+		return nil, fmt.Errorf("type (%s) not found", name)
+	}
+
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return nil, fmt.Errorf("type (%s) is not a struct", name)
+	}
+
+	v := &Struct{Name: name}
+
+	for _, astField := range st.Fields.List {
+		for _, ident := range astField.Names {
+			if !ident.IsExported() || skipField(ident.Name) {
+				continue
+			}
+
+			field := &Field{
+				Name:     ident.Name,
+				Required: strings.Contains(astField.Doc.Text(), "This member is required."),
+			}
+
+			s.resolveType(field, astField.Type, depth)
+
+			if field.Kind == KindUnsupported {
+				continue
+			}
+
+			v.Fields = append(v.Fields, field)
+		}
+	}
+
+	return v, nil
+}
+
+// resolveType sets `field`'s kind from its Go type expression.
+func (s *Service) resolveType(field *Field, expr ast.Expr, depth int) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		switch expr.Name {
+		case "bool":
+			field.Kind = KindBool
+		case "int32", "int64", "int":
+			field.Kind = KindInt64
+		case "float32", "float64":
+			field.Kind = KindFloat64
+		case "string":
+			field.Kind = KindString
+		default:
+			// Types package types reference each other unqualified.
+			s.resolveNamedType(field, expr.Name, depth)
+		}
+
+	case *ast.SelectorExpr:
+		pkg, ok := expr.X.(*ast.Ident)
+		if !ok {
+			return
+		}
+
+		switch pkg.Name {
+		case "time":
+			if expr.Sel.Name == "Time" {
+				field.Kind = KindTimestamp
+			}
+
+		case "types":
+			s.resolveNamedType(field, expr.Sel.Name, depth)
+		}
+
+	case *ast.ArrayType:
+		var elem Field
+		s.resolveType(&elem, expr.Elt, depth)
+
+		switch elem.Kind {
+		case KindString, KindEnum:
+			field.Kind = KindStringList
+			field.Enum = elem.Enum
+		case KindStruct:
+			field.Kind = KindStructList
+			field.Struct = elem.Struct
+		}
+
+	case *ast.MapType:
+		var key, elem Field
+		s.resolveType(&key, expr.Key, depth)
+		s.resolveType(&elem, expr.Value, depth)
+
+		if key.Kind == KindString && elem.Kind == KindString {
+			field.Kind = KindStringMap
+		}
+	}
+}
+
+// resolveNamedType sets `field`'s kind from the named type `name` in the service's types package.
+func (s *Service) resolveNamedType(field *Field, name string, depth int) {
+	spec, ok := s.types[name]
+	if !ok {
+		return
+	}
+
+	switch typ := spec.Type.(type) {
+	case *ast.Ident:
+		if typ.Name == "string" {
+			field.Kind = KindEnum
+			field.Enum = name
+		}
+
+	case *ast.StructType:
+		if depth >= maxDepth {
+			return
+		}
+
+		v, err := s.resolveStruct(s.types, name, depth+1)
+		if err != nil || len(v.Fields) == 0 {
+			return
+		}
+
+		field.Kind = KindStruct
+		field.Struct = v
+	}
+}
+
+// skipField returns whether the API field `name` is never part of a resource's schema.
+func skipField(name string) bool {
+	switch name {
+	case "ClientToken", "DryRun", "MaxResults", "NextToken", "ResultMetadata":
+		return true
+	}
+
+	return false
+}
+
+type parsedPackage struct {
+	name      string
+	typeSpecs map[string]*ast.TypeSpec
+	enums     map[string][]EnumValue
+}
+
+func parsePackage(fset *token.FileSet, dir string) (*parsedPackage, error) {
+	pkgs, err := parser.ParseDir(fset, dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing package (%s): %w", dir, err)
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("parsing package (%s): found %d packages, want 1", dir, len(pkgs))
+	}
+
+	p := &parsedPackage{
+		typeSpecs: make(map[string]*ast.TypeSpec),
+		enums:     make(map[string][]EnumValue),
+	}
+
+	for name, pkg := range pkgs {
+		p.name = name
+
+		// Walk files in name order so that enum values are in a stable order.
+		filenames := make([]string, 0, len(pkg.Files))
+		for filename := range pkg.Files {
+			filenames = append(filenames, filename)
+		}
+		sort.Strings(filenames)
+
+		for _, filename := range filenames {
+			for _, decl := range pkg.Files[filename].Decls {
+				decl, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						p.typeSpecs[spec.Name.Name] = spec
+
+					case *ast.ValueSpec:
+						if decl.Tok != token.CONST || spec.Type == nil || len(spec.Values) != len(spec.Names) {
+							continue
+						}
+
+						typ, ok := spec.Type.(*ast.Ident)
+						if !ok {
+							continue
+						}
+
+						for i, value := range spec.Values {
+							if lit, ok := value.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+								p.enums[typ.Name] = append(p.enums[typ.Name], EnumValue{
+									Const: spec.Names[i].Name,
+									Value: strings.Trim(lit.Value, "`\""),
+								})
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return p, nil
+}
@@ -0,0 +1,175 @@
+package shape
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	svc, err := Load("testdata/widgets")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := svc.Package, "widgets"; got != want {
+		t.Errorf("got package %s, expected %s", got, want)
+	}
+
+	if !svc.HasType("ResourceNotFoundException") {
+		t.Errorf("expected type ResourceNotFoundException")
+	}
+
+	if svc.HasType("Gadget") {
+		t.Errorf("unexpected type Gadget")
+	}
+
+	if !svc.HasPaginator("ListWidgets") {
+		t.Errorf("expected paginator ListWidgets")
+	}
+
+	if svc.HasPaginator("GetWidget") {
+		t.Errorf("unexpected paginator GetWidget")
+	}
+
+	want := []EnumValue{
+		{Const: "WidgetStatusCreating", Value: "CREATING"},
+		{Const: "WidgetStatusActive", Value: "ACTIVE"},
+		{Const: "WidgetStatusDeleting", Value: "DELETING"},
+		{Const: "WidgetStatusCreateFailed", Value: "CREATE_FAILED"},
+	}
+	if got := svc.EnumValues("WidgetStatus"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got enum values %v, expected %v", got, want)
+	}
+}
+
+func TestOperation(t *testing.T) {
+	svc, err := Load("testdata/widgets")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	configuration := &Struct{
+		Name: "WidgetConfiguration",
+		Fields: []*Field{
+			{Name: "Enabled", Kind: KindBool, Required: true},
+			{Name: "SecurityGroupIds", Kind: KindStringList},
+		},
+	}
+
+	testCases := []struct {
+		TestName    string
+		Operation   string
+		ExpectedIn  *Struct
+		ExpectedOut *Struct
+		ExpectError bool
+	}{
+		{
+			TestName:  "create",
+			Operation: "CreateWidget",
+			ExpectedIn: &Struct{
+				Name: "CreateWidgetInput",
+				Fields: []*Field{
+					{Name: "Name", Kind: KindString, Required: true},
+					{Name: "Configuration", Kind: KindStruct, Struct: configuration},
+					{Name: "Description", Kind: KindString},
+					{Name: "Size", Kind: KindInt64},
+					{Name: "Tags", Kind: KindStringMap},
+				},
+			},
+			ExpectedOut: &Struct{
+				Name: "CreateWidgetOutput",
+				Fields: []*Field{
+					{Name: "Arn", Kind: KindString},
+					{Name: "Id", Kind: KindString},
+					{Name: "Status", Kind: KindEnum, Enum: "WidgetStatus"},
+				},
+			},
+		},
+		{
+			TestName:  "read",
+			Operation: "GetWidget",
+			ExpectedIn: &Struct{
+				Name: "GetWidgetInput",
+				Fields: []*Field{
+					{Name: "WidgetIdentifier", Kind: KindString, Required: true},
+				},
+			},
+			ExpectedOut: &Struct{
+				Name: "GetWidgetOutput",
+				Fields: []*Field{
+					{Name: "Widget", Kind: KindStruct, Struct: &Struct{
+						Name: "Widget",
+						Fields: []*Field{
+							{Name: "Arn", Kind: KindString},
+							{Name: "Configuration", Kind: KindStruct, Struct: configuration},
+							{Name: "CreatedAt", Kind: KindTimestamp},
+							{Name: "Description", Kind: KindString},
+							{Name: "Id", Kind: KindString},
+							{Name: "Name", Kind: KindString},
+							{Name: "Size", Kind: KindInt64},
+							{Name: "Status", Kind: KindEnum, Enum: "WidgetStatus"},
+							{Name: "Tags", Kind: KindStringMap},
+						},
+					}},
+				},
+			},
+		},
+		{
+			TestName:  "list",
+			Operation: "ListWidgets",
+			ExpectedIn: &Struct{
+				Name: "ListWidgetsInput",
+			},
+			ExpectedOut: &Struct{
+				Name: "ListWidgetsOutput",
+				Fields: []*Field{
+					{Name: "Items", Kind: KindStructList, Struct: &Struct{
+						Name: "WidgetSummary",
+						Fields: []*Field{
+							{Name: "Arn", Kind: KindString},
+							{Name: "Id", Kind: KindString},
+							{Name: "Name", Kind: KindString},
+						},
+					}},
+				},
+			},
+		},
+		{
+			TestName:    "not found",
+			Operation:   "DescribeWidget",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			in, out, err := svc.Operation(testCase.Operation)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(in, testCase.ExpectedIn) {
+				t.Errorf("got input %s, expected %s", dump(in), dump(testCase.ExpectedIn))
+			}
+
+			if !reflect.DeepEqual(out, testCase.ExpectedOut) {
+				t.Errorf("got output %s, expected %s", dump(out), dump(testCase.ExpectedOut))
+			}
+		})
+	}
+}
+
+func dump(v *Struct) string {
+	b, _ := json.Marshal(v)
+
+	return string(b)
+}
@@ -0,0 +1,50 @@
+// Package widgets is a minimal AWS SDK for Go v2 style service package used as a test fixture.
+package widgets
+
+import (
+	"github.com/aws/smithy-go/middleware"
+
+	"example.com/widgets/types"
+)
+
+type CreateWidgetInput struct {
+
+	// The name of the widget.
+	//
+	// This member is required.
+	Name *string
+
+	// A client token.
+	ClientToken *string
+
+	// The widget's configuration.
+	Configuration *types.WidgetConfiguration
+
+	// The widget's description.
+	Description *string
+
+	// The widget's size.
+	Size *int32
+
+	// The tags for the widget.
+	Tags map[string]string
+
+	noSmithyDocumentSerde
+}
+
+type CreateWidgetOutput struct {
+
+	// The ARN of the widget.
+	Arn *string
+
+	// The ID of the widget.
+	Id *string
+
+	// The widget's status.
+	Status types.WidgetStatus
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
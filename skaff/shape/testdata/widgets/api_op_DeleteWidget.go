@@ -0,0 +1,23 @@
+package widgets
+
+import (
+	"github.com/aws/smithy-go/middleware"
+)
+
+type DeleteWidgetInput struct {
+
+	// The ID or ARN of the widget.
+	//
+	// This member is required.
+	WidgetIdentifier *string
+
+	noSmithyDocumentSerde
+}
+
+type DeleteWidgetOutput struct {
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
@@ -0,0 +1,28 @@
+package widgets
+
+import (
+	"github.com/aws/smithy-go/middleware"
+
+	"example.com/widgets/types"
+)
+
+type GetWidgetInput struct {
+
+	// The ID or ARN of the widget.
+	//
+	// This member is required.
+	WidgetIdentifier *string
+
+	noSmithyDocumentSerde
+}
+
+type GetWidgetOutput struct {
+
+	// The widget.
+	Widget *types.Widget
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
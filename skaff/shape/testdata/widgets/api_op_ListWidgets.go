@@ -0,0 +1,37 @@
+package widgets
+
+import (
+	"github.com/aws/smithy-go/middleware"
+
+	"example.com/widgets/types"
+)
+
+type ListWidgetsInput struct {
+
+	// The maximum number of results to return.
+	MaxResults *int32
+
+	// The pagination token.
+	NextToken *string
+
+	noSmithyDocumentSerde
+}
+
+type ListWidgetsOutput struct {
+
+	// The widgets.
+	Items []types.WidgetSummary
+
+	// The pagination token.
+	NextToken *string
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
+
+// ListWidgetsPaginator is a paginator for ListWidgets.
+type ListWidgetsPaginator struct {
+	nextToken *string
+}
@@ -0,0 +1,26 @@
+package widgets
+
+import (
+	"github.com/aws/smithy-go/middleware"
+)
+
+type UpdateWidgetInput struct {
+
+	// The ID or ARN of the widget.
+	//
+	// This member is required.
+	WidgetIdentifier *string
+
+	// The widget's description.
+	Description *string
+
+	noSmithyDocumentSerde
+}
+
+type UpdateWidgetOutput struct {
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
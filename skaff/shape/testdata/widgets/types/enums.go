@@ -0,0 +1,11 @@
+package types
+
+type WidgetStatus string
+
+// Enum values for WidgetStatus
+const (
+	WidgetStatusCreating     WidgetStatus = "CREATING"
+	WidgetStatusActive       WidgetStatus = "ACTIVE"
+	WidgetStatusDeleting     WidgetStatus = "DELETING"
+	WidgetStatusCreateFailed WidgetStatus = "CREATE_FAILED"
+)
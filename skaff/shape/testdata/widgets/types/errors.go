@@ -0,0 +1,6 @@
+package types
+
+// The requested resource does not exist.
+type ResourceNotFoundException struct {
+	Message *string
+}
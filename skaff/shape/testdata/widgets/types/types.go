@@ -0,0 +1,70 @@
+package types
+
+import (
+	"time"
+)
+
+// A widget.
+type Widget struct {
+
+	// The ARN of the widget.
+	Arn *string
+
+	// The widget's configuration.
+	Configuration *WidgetConfiguration
+
+	// The date and time that the widget was created.
+	CreatedAt *time.Time
+
+	// The widget's description.
+	Description *string
+
+	// The ID of the widget.
+	Id *string
+
+	// The name of the widget.
+	Name *string
+
+	// The widget's size.
+	Size *int32
+
+	// The widget's status.
+	Status WidgetStatus
+
+	// The tags for the widget.
+	Tags map[string]string
+
+	noSmithyDocumentSerde
+}
+
+// A widget's configuration.
+type WidgetConfiguration struct {
+
+	// Whether the widget is enabled.
+	//
+	// This member is required.
+	Enabled *bool
+
+	// The widget's ports.
+	Ports []int32
+
+	// The widget's security group IDs.
+	SecurityGroupIds []string
+
+	noSmithyDocumentSerde
+}
+
+// Summary information about a widget.
+type WidgetSummary struct {
+
+	// The ARN of the widget.
+	Arn *string
+
+	// The ID of the widget.
+	Id *string
+
+	// The name of the widget.
+	Name *string
+
+	noSmithyDocumentSerde
+}